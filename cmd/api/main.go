@@ -1,17 +1,34 @@
 package main
 
 import (
+	"context"
+	"io"
 	"mcanvr/example-golang-api-with-fiber/internal/application/service"
 	"mcanvr/example-golang-api-with-fiber/internal/config"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
 	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/breach"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/eventbus"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/hashing"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/i18n"
 	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/mail"
 	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/ratelimit"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/secret"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/totp"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/worker"
 	"mcanvr/example-golang-api-with-fiber/internal/interfaces/api"
 	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
 	"mcanvr/example-golang-api-with-fiber/internal/interfaces/middleware"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
+	"time"
+	_ "time/tzdata" // Embed the time zone database for user time zones
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -33,58 +50,269 @@ import (
 // @name Authorization
 // @description JWT Authorization header using the Bearer scheme. Example: "Bearer {token}"
 
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description API key for service-to-service callers, accepted by the user endpoints according to its scopes
+
+// @securityDefinitions.basic ClientAuth
+// @description OAuth client ID and secret, for the /oauth endpoints
+
 // @Security BearerAuth
 
 // customErrorHandler handles all errors that occur during request processing
 // It formats errors in a consistent way throughout the API
 func customErrorHandler(c fiber.Ctx, err error) error {
+	// Handle errors registered in pkg/errors
+	if problem, ok := common.ProblemFor(err, constants.GeneralError); ok {
+		return common.SendProblem(c, problem)
+	}
+
 	// Handle Fiber-specific errors
 	if fiberErr, ok := err.(*fiber.Error); ok {
-		return c.Status(fiberErr.Code).JSON(common.NewErrorResponse(
-			constants.GeneralError,
-			fiberErr.Message,
-		))
+		return common.SendError(c, fiberErr.Code, common.CodeForStatus(fiberErr.Code),
+			constants.GeneralError, fiberErr.Message)
 	}
 
 	// Handle other errors as general internal server errors
 	logger.Error(constants.UnexpectedError, err)
-	return c.Status(fiber.StatusInternalServerError).JSON(common.NewErrorResponse(
-		constants.InternalServerError,
-		constants.UserFriendlyServerError,
-	))
+	return common.SendError(c, fiber.StatusInternalServerError, appErrors.CodeInternal,
+		constants.InternalServerError, constants.UserFriendlyServerError)
+}
+
+// newSecretProvider returns the provider for runtime secrets.
+// Secrets loaded from a file are read again when the file changes,
+// so they can be rotated without restarting the server, and validated
+// like the configuration each time.
+func newSecretProvider(cfg *config.Config) service.SecretProvider {
+	if path := cfg.SecretFile("JWT_SECRET"); path != "" {
+		return secret.NewFileProvider(map[string]string{service.JWTSecretName: path}, func(_, value string) error {
+			return cfg.ValidateSecret("JWT_SECRET", value)
+		})
+	}
+	return secret.NewMemoryProvider(map[string]string{service.JWTSecretName: cfg.JWTSecret})
+}
+
+// newMailer returns the mailer for outgoing email: messages are written as files
+// to MAIL_DIR if it is set, or to the log otherwise.
+func newMailer(cfg *config.Config) (service.Mailer, error) {
+	if cfg.MailDir != "" {
+		return mail.NewFileMailer(cfg.MailDir)
+	}
+	return mail.NewLogMailer(), nil
+}
+
+// newBreachedPasswordChecker returns the breached-password checker configured by cfg,
+// or nil to skip the check
+func newBreachedPasswordChecker(cfg *config.Config) service.BreachedPasswordChecker {
+	if cfg.PasswordBreachedDir == "" {
+		return nil
+	}
+	if _, err := os.Stat(cfg.PasswordBreachedDir); err != nil {
+		logger.Warn(constants.BreachListMissing, cfg.PasswordBreachedDir)
+		return nil
+	}
+	return breach.NewPrefixFileChecker(cfg.PasswordBreachedDir)
+}
+
+// closers returns the repositories that hold resources to release on shutdown.
+func closers(repositories ...any) []io.Closer {
+	var result []io.Closer
+	for _, repo := range repositories {
+		if closer, ok := repo.(io.Closer); ok {
+			result = append(result, closer)
+		}
+	}
+	return result
+}
+
+// shutdown stops the application in order: it stops accepting new connections
+// and drains in-flight requests, stops background workers, closes repositories
+// and finally flushes the logs. The steps share one grace period, so the whole
+// shutdown ends within timeout.
+func shutdown(app *fiber.App, workers *worker.Group, timeout time.Duration, repositories []io.Closer) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// Stop accepting connections and wait for in-flight requests
+	if err := app.ShutdownWithContext(ctx); err != nil {
+		logger.Error(constants.ServerShutdownFailed, err)
+	}
+
+	// Stop background workers
+	if err := workers.Stop(ctx); err != nil {
+		logger.Error(constants.WorkersStopFailed, err)
+	}
+
+	// Close repositories once nothing can use them anymore
+	for _, repo := range repositories {
+		if err := repo.Close(); err != nil {
+			logger.Error(constants.RepositoryCloseFailed, repo, err)
+		}
+	}
+
+	logger.Info(constants.ServerStopped)
+	_ = logger.Sync()
 }
 
 func main() {
+	// Create configuration from flags, config file and environment
+	cfg, cfgLoader := config.New(os.Args[1:])
+
 	// Setup logger
 	logConfig := logger.DefaultConfig()
-	if os.Getenv("ENV") == "development" {
-		logConfig.Level = logger.DEBUG
-	}
+	logConfig.Level, _ = logger.ParseLevel(cfg.LogLevel)
 	appLogger := logger.NewLogger(logConfig)
 	logger.SetDefaultLogger(appLogger)
 
-	// Create configuration
-	cfg := config.New()
-
 	// Setup repositories
+	tenantRepo := inmemory.NewInMemoryTenantRepository()
 	userRepo := inmemory.NewInMemoryUserRepository()
+	loginAttemptRepo := inmemory.NewInMemoryLoginAttemptRepository()
+	auditRepo := inmemory.NewInMemoryAuditRepository()
+	emailVerificationRepo := inmemory.NewInMemoryEmailVerificationRepository()
+	passwordResetRepo := inmemory.NewInMemoryPasswordResetRepository()
+	mfaCredentialRepo := inmemory.NewInMemoryMFACredentialRepository()
+	apiKeyRepo := inmemory.NewInMemoryAPIKeyRepository()
+	oauthClientRepo := inmemory.NewInMemoryOAuthClientRepository()
+	oauthRefreshTokenRepo := inmemory.NewInMemoryOAuthRefreshTokenRepository()
+	revokedTokenRepo := inmemory.NewInMemoryRevokedTokenRepository()
+	sessionRepo := inmemory.NewInMemorySessionRepository()
+	organizationRepo := inmemory.NewInMemoryOrganizationRepository()
+	teamRepo := inmemory.NewInMemoryTeamRepository()
+	invitationRepo := inmemory.NewInMemoryInvitationRepository()
 
 	// Initialize with sample data
+	if err := inmemory.InitializeWithSampleTenants(tenantRepo); err != nil {
+		logger.Warn(constants.SampleDataInitFailed, err)
+	}
 	if err := inmemory.InitializeWithSampleData(userRepo); err != nil {
 		logger.Warn(constants.SampleDataInitFailed, err)
 	}
 
+	// Setup domain event delivery
+	eventBus := eventbus.NewBus()
+
+	// Setup tenants, resolved for every request
+	tenantService := service.NewTenantService(tenantRepo)
+
 	// Setup domain services
-	userDomainService := domainService.NewUserService(userRepo)
+	userDomainService := domainService.NewUserService(userRepo, eventBus)
+
+	// Setup JWT service
+	secrets := newSecretProvider(cfg)
+	jwtService := service.NewJWTService(secrets, revokedTokenRepo, service.JWTPolicy{
+		Issuer:   cfg.JWTIssuer,
+		Audience: cfg.JWTAudience,
+		TTL:      time.Duration(cfg.JWTExpirationHours) * time.Hour,
+	})
+
+	// Setup email verification, sending a link whenever a user registers or changes their email
+	mailer, err := newMailer(cfg)
+	if err != nil {
+		logger.Fatal(constants.MailerInitFailed, err)
+	}
+	emailVerificationService := service.NewEmailVerificationService(userDomainService, emailVerificationRepo, secrets, mailer,
+		service.EmailVerificationPolicy{
+			URL:            cfg.EmailVerificationURL,
+			TTL:            time.Duration(cfg.EmailVerificationTTLMin) * time.Minute,
+			ResendInterval: time.Duration(cfg.EmailVerificationResendSec) * time.Second,
+		})
+	eventBus.Subscribe(model.EventUserRegistered, emailVerificationService.HandleEmailChanged)
+	eventBus.Subscribe(model.EventUserEmailChanged, emailVerificationService.HandleEmailChanged)
+
+	// Setup audit service
+	auditService := service.NewAuditService(auditRepo)
+	eventBus.Subscribe(model.EventUserStatusChanged, auditService.HandleUserStatusChanged)
+
+	// Setup API keys for service-to-service callers
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, auditService)
+
+	// Setup multi-factor authentication
+	mfaService := service.NewMFAService(userDomainService, mfaCredentialRepo, totp.NewGenerator(1), secrets, auditService,
+		service.MFAPolicy{
+			Issuer:       cfg.MFAIssuer,
+			ChallengeTTL: time.Duration(cfg.MFAChallengeTTLMin) * time.Minute,
+		})
+
+	// Setup sessions of signed-in users, renewed with refresh tokens
+	sessionService := service.NewSessionService(sessionRepo, userDomainService, auditService,
+		time.Duration(cfg.SessionTTLHours)*time.Hour)
+
+	// Setup auth service with brute-force protection
+	failureWindow := time.Duration(cfg.LoginFailureWindowMin) * time.Minute
+	lockoutDuration := time.Duration(cfg.LoginLockoutMin) * time.Minute
+	passwordHasher := hashing.NewBcryptHasher(0)
+	authService := service.NewAuthService(userDomainService, jwtService, mfaService, sessionService, passwordHasher, loginAttemptRepo, service.LoginProtection{
+		Account: model.LockoutPolicy{
+			MaxFailures:     cfg.LoginMaxFailures,
+			Window:          failureWindow,
+			LockoutDuration: lockoutDuration,
+			BaseDelay:       time.Second,
+			MaxDelay:        30 * time.Second,
+		},
+		IP: model.LockoutPolicy{
+			MaxFailures:     cfg.LoginIPMaxFailures,
+			Window:          failureWindow,
+			LockoutDuration: lockoutDuration,
+		},
+	}, auditService)
+
+	// Setup the OAuth2 authorization server for registered clients
+	oauthService := service.NewOAuthService(oauthClientRepo, oauthRefreshTokenRepo, jwtService, authService, auditService,
+		service.OAuthPolicy{
+			AccessTokenTTL:  time.Duration(cfg.OAuthAccessTokenTTLMin) * time.Minute,
+			RefreshTokenTTL: time.Duration(cfg.OAuthRefreshTokenTTLHours) * time.Hour,
+		})
+
+	// Setup impersonation of users by administrators
+	impersonationService := service.NewImpersonationService(userDomainService, authService, jwtService, auditService,
+		time.Duration(cfg.ImpersonationTTLMin)*time.Minute)
+
+	// Setup the password policy applied to new passwords
+	passwordValidator := service.NewPasswordValidator(model.PasswordPolicy{
+		MinLength:            cfg.PasswordMinLength,
+		MaxLength:            cfg.PasswordMaxLength,
+		MinCharClasses:       cfg.PasswordMinCharClasses,
+		DisallowPersonalInfo: cfg.PasswordDisallowPersonalInfo,
+	}, newBreachedPasswordChecker(cfg))
+
+	// Setup self-service password changes and resets
+	passwordService := service.NewPasswordService(userDomainService, passwordResetRepo, passwordHasher, passwordValidator, mailer, auditService,
+		service.PasswordResetPolicy{
+			URL: cfg.PasswordResetURL,
+			TTL: time.Duration(cfg.PasswordResetTTLMin) * time.Minute,
+		})
 
 	// Setup application services
-	userAppService := service.NewUserApplicationService(userDomainService)
+	userAppService := service.NewUserApplicationService(userDomainService, passwordService, auditService)
 
-	// Setup JWT service
-	jwtService := service.NewJWTService(cfg.JWTSecret, cfg.JWTExpirationHours)
+	// Setup organizations and teams, with invitations by email
+	organizationService := service.NewOrganizationService(organizationRepo, teamRepo, invitationRepo, userDomainService, auditService)
+	invitationService := service.NewInvitationService(organizationService, invitationRepo, userDomainService, mailer, auditService,
+		service.InvitationPolicy{
+			URL: cfg.OrgInvitationURL,
+			TTL: time.Duration(cfg.OrgInvitationTTLHours) * time.Hour,
+		})
+
+	// Setup background workers
+	workers := worker.NewGroup()
 
-	// Setup auth service
-	authService := service.NewAuthService(userDomainService, jwtService)
+	// Setup rate limiting, with counters shared by all rate limit policies
+	rateLimitStore := ratelimit.NewMemoryStore()
+	workers.Go("rate-limit-cleanup", rateLimitStore.RunCleanup)
+	workers.Go("login-attempt-cleanup", authService.RunAttemptCleanup)
+	rateLimiter := middleware.NewRateLimiter(rateLimitStore, api.RateLimitPolicies(cfg)...)
+
+	// Apply settings that are safe to change live when the configuration is reloaded
+	workers.Go("config-reload", func(ctx context.Context) {
+		cfgLoader.Watch(ctx, func(newCfg *config.Config) {
+			if level, err := logger.ParseLevel(newCfg.LogLevel); err == nil {
+				logger.SetLevel(level)
+			}
+			rateLimiter.SetPolicies(api.RateLimitPolicies(newCfg)...)
+		})
+	})
 
 	// Create Fiber app
 	app := fiber.New(fiber.Config{
@@ -95,19 +323,31 @@ func main() {
 	// Setup middleware
 	app.Use(middleware.Logger())
 	app.Use(middleware.Recover())
-	app.Use(middleware.ConfigureDefaultCORS())
-	app.Use(middleware.ConfigureDefaultRateLimiter())
-	app.Use(middleware.DefaultRequestTimeout())
+	app.Use(middleware.Localization(i18n.Default()))
+	app.Use(middleware.Tenant(tenantService, cfg.TenantBaseDomain))
+	app.Use(middleware.ConfigureCORS(cfg.CORSAllowOrigins, cfg.CORSAllowCredentials))
+	app.Use(rateLimiter.Limit("global"))
+	app.Use(middleware.RequestTimeout(time.Duration(cfg.RequestTimeoutSec) * time.Second))
 
 	// Create JWT middleware
-	jwtMiddleware := middleware.JWTProtected(jwtService)
+	jwtMiddleware := middleware.JWTProtected(jwtService, authService, sessionService, impersonationService)
 
 	// Setup controllers
 	userController := api.NewUserController(userAppService)
 	authController := api.NewAuthController(authService)
+	emailController := api.NewEmailController(emailVerificationService)
+	passwordController := api.NewPasswordController(passwordService)
+	mfaController := api.NewMFAController(mfaService, authService)
+	apiKeyController := api.NewAPIKeyController(apiKeyService)
+	oauthController := api.NewOAuthController(oauthService)
+	impersonationController := api.NewImpersonationController(impersonationService)
+	sessionController := api.NewSessionController(sessionService)
+	organizationController := api.NewOrganizationController(organizationService)
+	invitationController := api.NewInvitationController(invitationService)
 
 	// Setup routes
-	api.SetupRoutes(app, cfg, userController, authController, jwtMiddleware)
+	api.SetupRoutes(app, cfg, userController, authController, emailController, passwordController, mfaController, apiKeyController, oauthController,
+		impersonationController, sessionController, organizationController, invitationController, jwtMiddleware, middleware.APIKeyOrJWT(apiKeyService, jwtMiddleware), rateLimiter)
 
 	// Serve Swagger documentation
 	app.Get("/swagger/*", func(c fiber.Ctx) error {
@@ -125,15 +365,33 @@ func main() {
 
 	// Setup 404 handler
 	app.Use(func(c fiber.Ctx) error {
-		return c.Status(fiber.StatusNotFound).JSON(common.NewErrorResponse(
-			constants.EndpointNotFound,
-			constants.ResourceNotFound,
-		))
+		return common.SendError(c, fiber.StatusNotFound, appErrors.CodeNotFound,
+			constants.EndpointNotFound, constants.ResourceNotFound)
 	})
 
 	// Start server
-	logger.Info(constants.ServerStarting, cfg.ServerAddress)
-	if err := app.Listen(cfg.ServerAddress); err != nil {
-		logger.Fatal(constants.ServerStartFailed, err)
+	serverErr := make(chan error, 1)
+	go func() {
+		logger.Info(constants.ServerStarting, cfg.ServerAddress)
+		serverErr <- app.Listen(cfg.ServerAddress)
+	}()
+
+	// Wait for a termination signal or a server failure
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case sig := <-quit:
+		logger.Info(constants.ShutdownSignalReceived, sig)
+	case err := <-serverErr:
+		if err != nil {
+			logger.Fatal(constants.ServerStartFailed, err)
+		}
 	}
+
+	shutdown(app, workers, time.Duration(cfg.ShutdownTimeoutSec)*time.Second, closers(
+		tenantRepo, userRepo, loginAttemptRepo, auditRepo, emailVerificationRepo, passwordResetRepo, mfaCredentialRepo,
+		apiKeyRepo, oauthClientRepo, oauthRefreshTokenRepo, revokedTokenRepo, sessionRepo,
+		organizationRepo, teamRepo, invitationRepo,
+	))
 }
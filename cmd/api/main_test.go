@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/worker"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// closerFunc adapts a function to io.Closer
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
+
+func TestClosers(t *testing.T) {
+	closer := closerFunc(func() error { return nil })
+
+	got := closers(closer, "not a closer", nil, closer)
+	if len(got) != 2 {
+		t.Errorf("closers() returned %d closers, want 2", len(got))
+	}
+}
+
+func TestShutdownClosesEveryRepository(t *testing.T) {
+	closed := 0
+	repositories := []io.Closer{
+		closerFunc(func() error { closed++; return errors.New("disk gone") }),
+		closerFunc(func() error { closed++; return nil }),
+	}
+
+	shutdown(fiber.New(), worker.NewGroup(), time.Second, repositories)
+
+	if closed != 2 {
+		t.Errorf("shutdown() closed %d repositories, want 2 even when one fails", closed)
+	}
+}
+
+func TestShutdownSharesGracePeriod(t *testing.T) {
+	// A request still in flight holds up the server for the whole grace period
+	app := fiber.New()
+	started := make(chan struct{})
+	app.Get("/", func(c fiber.Ctx) error {
+		close(started)
+		time.Sleep(2 * time.Second)
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() unexpected error = %v", err)
+	}
+	go func() { _ = app.Listener(ln, fiber.ListenConfig{DisableStartupMessage: true}) }()
+	go func() {
+		if resp, err := http.Get("http://" + ln.Addr().String()); err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+
+	// A worker that ignores cancellation holds up its step as well
+	workers := worker.NewGroup()
+	workers.Go("stuck", func(context.Context) { time.Sleep(2 * time.Second) })
+
+	const timeout = 300 * time.Millisecond
+	start := time.Now()
+	shutdown(app, workers, timeout, nil)
+
+	if elapsed := time.Since(start); elapsed > timeout+150*time.Millisecond {
+		t.Errorf("shutdown() took %v, want about the %v grace period", elapsed, timeout)
+	}
+}
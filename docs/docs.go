@@ -23,30 +23,21 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/login": {
-            "post": {
-                "description": "Authenticates with username and password to receive a JWT token",
-                "consumes": [
-                    "application/json"
+        "/admin/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Lists all API keys, including expired and revoked ones, without their secrets (admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
-                ],
-                "summary": "User login",
-                "parameters": [
-                    {
-                        "description": "User credentials",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.LoginRequest"
-                        }
-                    }
+                    "api-keys"
                 ],
+                "summary": "List API keys",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -59,42 +50,43 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/internal_interfaces_api.LoginResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.APIKeyResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     }
                 }
-            }
-        },
-        "/users": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves all users in the system",
+                "description": "Creates an API key with the given scopes and optional expiry (admin only). The key is returned only once; send it in the X-API-Key header",
                 "consumes": [
                     "application/json"
                 ],
@@ -102,12 +94,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "api-keys"
+                ],
+                "summary": "Create API key",
+                "parameters": [
+                    {
+                        "description": "API key",
+                        "name": "key",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.APIKeyRequest"
+                        }
+                    }
                 ],
-                "summary": "List all users",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -117,61 +120,67 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
-                                            }
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.APIKeyCreatedResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Invalid request or scope",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope, not an administrator, or a scope the token used does not hold",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/admin/api-keys/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new user record",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Permanently disables an API key (admin only). Revoked keys remain listed",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "api-keys"
                 ],
-                "summary": "Create new user",
+                "summary": "Revoke API key",
                 "parameters": [
                     {
-                        "description": "User information",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserRequest"
-                        }
+                        "type": "integer",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -181,7 +190,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.APIKeyResponse"
                                         }
                                     }
                                 }
@@ -189,44 +198,59 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request or user already exists",
+                        "description": "Invalid ID format",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "API key not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "API key already revoked",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     }
                 }
             }
         },
-        "/users/{id}": {
-            "get": {
+        "/admin/impersonate/{id}": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves a user by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Issues a short-lived token to act as the user, for reproducing issues (admin only). The token names the administrator in an \"act\" claim (RFC 8693), cannot change the user's password or MFA settings, and every request made with it is recorded in the audit log",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth"
                 ],
-                "summary": "Show user details",
+                "summary": "Impersonate user",
                 "parameters": [
                     {
                         "type": "integer",
@@ -248,7 +272,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                            "$ref": "#/definitions/internal_interfaces_api.ImpersonationResponse"
                                         }
                                     }
                                 }
@@ -258,36 +282,100 @@ const docTemplate = `{
                     "400": {
                         "description": "Invalid ID format",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope, not an administrator, user cannot be impersonated or account disabled",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "404": {
                         "description": "User not found",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/oauth-clients": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists all registered OAuth clients without their secrets (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "List OAuth clients",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthClientResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates a user by ID",
+                "description": "Registers an OAuth client allowed the given scopes (admin only). The client secret is returned only once",
                 "consumes": [
                     "application/json"
                 ],
@@ -295,30 +383,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "oauth"
                 ],
-                "summary": "Update user",
+                "summary": "Register OAuth client",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated user information",
-                        "name": "user",
+                        "description": "OAuth client",
+                        "name": "client",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserRequest"
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthClientRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -328,7 +409,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthClientCreatedResponse"
                                         }
                                     }
                                 }
@@ -336,60 +417,59 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request or email already used",
+                        "description": "Invalid request or scope",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "403": {
+                        "description": "Insufficient scope, not an administrator, or a scope the token used does not hold",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/oauth-clients/{id}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a user by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Deletes an OAuth client and its refresh tokens (admin only). Access tokens already issued remain valid until they expire",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "oauth"
                 ],
-                "summary": "Delete user",
+                "summary": "Delete OAuth client",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "description": "OAuth client ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
                         }
@@ -397,64 +477,4809 @@ const docTemplate = `{
                     "400": {
                         "description": "Invalid ID format",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "OAuth client not found",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "internal_interfaces_api.LoginRequest": {
-            "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
-            "properties": {
-                "password": {
-                    "type": "string"
-                },
-                "username": {
-                    "type": "string"
-                }
-            }
-        },
-        "internal_interfaces_api.LoginResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
-                    "type": "string"
-                }
-            }
         },
-        "internal_interfaces_api.ResponseModel": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "message": {
+        "/admin/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Clears failed login attempts and lockouts for a username and/or client IP address (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Clear login lockout",
+                "parameters": [
+                    {
+                        "description": "Account and/or IP address to unlock",
+                        "name": "unlock",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.UnlockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the devices a user is signed in on (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "List user sessions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.SessionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/sessions/{sessionId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Signs a user out of a device: the session's refresh token stops working and its access tokens are rejected (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "Revoke user session",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Session ID",
+                        "name": "sessionId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/email/verify": {
+            "post": {
+                "description": "Confirms the email address with the single-use token sent in the verification link",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "email"
+                ],
+                "summary": "Verify email address",
+                "parameters": [
+                    {
+                        "description": "Verification token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.VerifyEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid, expired or used token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/email/verify/resend": {
+            "post": {
+                "description": "Sends a new verification link if the address belongs to an unverified user. Always responds with 202 so it can't be used to discover accounts; links are sent at most once per resend interval",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "email"
+                ],
+                "summary": "Resend verification email",
+                "parameters": [
+                    {
+                        "description": "Email address",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResendVerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Authenticates with username and password to start a session and receive a JWT token and a refresh token, granted the requested scopes or all scopes. Users with multi-factor authentication enabled receive an MFA token to complete at /login/mfa instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "User credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_interfaces_api.LoginResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or scope",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts or account locked",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/mfa": {
+            "post": {
+                "description": "Exchanges the MFA token returned by /login and a code from the authenticator app, or an unused recovery code, for a JWT token and a refresh token. Wrong codes count towards the account lockout",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Complete login with MFA",
+                "parameters": [
+                    {
+                        "description": "MFA token and code",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.MFALoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_interfaces_api.LoginResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid code or expired MFA token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Account disabled",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts or account locked",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/refresh": {
+            "post": {
+                "description": "Exchanges the refresh token of a session for a new JWT token and a new refresh token. Each refresh token can be used once; sessions revoked, expired or started before the user's last password change are rejected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Refresh access token",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "refresh",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_interfaces_api.LoginResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid, used or revoked refresh token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Account disabled",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the profile of the signed-in user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Show my profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the signed-in user's account, signing them out everywhere. Not allowed while impersonating",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete my account",
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope, not a signed-in user, or impersonating",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the fields present in the request on the signed-in user's profile, leaving the others unchanged. A new email address must be verified again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update my profile",
+                "parameters": [
+                    {
+                        "description": "Fields to update",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or email already used",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope, not a signed-in user, or changing the email address while impersonating",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the signed-in user's password after checking the current one. The new password must satisfy the password policy. Every session and token issued before the change is revoked, including the one making the request. Not allowed while impersonating",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Change my password",
+                "parameters": [
+                    {
+                        "description": "Current and new password",
+                        "name": "password",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.PasswordChangeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, incorrect current password or password policy violation",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope, not a signed-in user, or impersonating",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the devices the signed-in user is signed in on, flagging the session of the request as current",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "List my sessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.SessionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Signs the signed-in user out of a device: the session's refresh token stops working and its access tokens are rejected. Not allowed while impersonating",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "Revoke my session",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope, not a signed-in user, or impersonating",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/mfa/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Enables MFA with a code from the authenticator app and returns single-use recovery codes, which are shown only once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mfa"
+                ],
+                "summary": "Confirm MFA enrollment",
+                "parameters": [
+                    {
+                        "description": "Authenticator code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.MFACodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.MFARecoveryCodesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized or invalid code",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed while impersonating",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "MFA already enabled or enrollment not started",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many wrong codes",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/mfa/disable": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Disables MFA after checking a current authenticator code or an unused recovery code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mfa"
+                ],
+                "summary": "Disable MFA",
+                "parameters": [
+                    {
+                        "description": "Authenticator or recovery code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.MFACodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized or invalid code",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed while impersonating",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "MFA not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many wrong codes",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/mfa/enroll": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a TOTP secret for the signed-in user. Show the provisioning URI as a QR code for the authenticator app, then confirm with a code. MFA is not enabled until confirmed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mfa"
+                ],
+                "summary": "Start MFA enrollment",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.MFAEnrollmentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed while impersonating",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "MFA already enabled",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth/introspect": {
+            "post": {
+                "security": [
+                    {
+                        "ClientAuth": []
+                    }
+                ],
+                "description": "Reports whether an access or refresh token is active and describes it (RFC 7662). Access tokens of users are only described to clients allowed the tokens:introspect scope, and refresh tokens only to the client they were issued to",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Introspect a token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token to describe",
+                        "name": "token",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "access_token or refresh_token",
+                        "name": "token_type_hint",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthIntrospectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid_request",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid_client",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "server_error",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth/revoke": {
+            "post": {
+                "security": [
+                    {
+                        "ClientAuth": []
+                    }
+                ],
+                "description": "Revokes an access or refresh token issued to the client (RFC 7009). Unknown tokens and tokens of other clients are ignored",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Revoke a token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token to revoke",
+                        "name": "token",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "access_token or refresh_token",
+                        "name": "token_type_hint",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Token revoked or ignored"
+                    },
+                    "400": {
+                        "description": "invalid_request",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid_client",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "server_error",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth/token": {
+            "post": {
+                "security": [
+                    {
+                        "ClientAuth": []
+                    }
+                ],
+                "description": "Issues an access token to a registered client (RFC 6749), with the client_credentials or refresh_token grant, and a single-use refresh token to clients registered for refresh tokens. The client authenticates with HTTP Basic or the client_id and client_secret parameters",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Issue OAuth2 tokens",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "client_credentials or refresh_token",
+                        "name": "grant_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Space-separated scopes, defaults to all scopes allowed",
+                        "name": "scope",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Refresh token, for the refresh_token grant",
+                        "name": "refresh_token",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client ID, unless sent with HTTP Basic",
+                        "name": "client_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client secret, unless sent with HTTP Basic",
+                        "name": "client_secret",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid_request, invalid_grant, invalid_scope or unsupported_grant_type",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid_client",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "server_error",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the organizations the signed-in user is a member of, with their role in each",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "List my organizations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OrganizationResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates an organization owned by the signed-in user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Create organization",
+                "parameters": [
+                    {
+                        "description": "Organization",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OrganizationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OrganizationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/invitations/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Joins the team of an invitation with the token from the invitation email, and its organization if needed. The signed-in user's verified email address must be the one the invitation was sent to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "Accept invitation",
+                "parameters": [
+                    {
+                        "description": "Invitation token",
+                        "name": "invitation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.InvitationTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.TeamResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or invitation",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Already a member of the team",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/invitations/decline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Turns down an invitation with the token from the invitation email. The signed-in user's verified email address must be the one the invitation was sent to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "Decline invitation",
+                "parameters": [
+                    {
+                        "description": "Invitation token",
+                        "name": "invitation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.InvitationTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or invitation",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{orgId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves an organization the signed-in user is a member of",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Show organization",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OrganizationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renames an organization (organization owners and admins)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Update organization",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Organization",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OrganizationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.OrganizationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes an organization with its teams and their invitations (organization owners)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Delete organization",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{orgId}/members": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the members of an organization the signed-in user is a member of, with their roles",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "List organization members",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.MemberResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{orgId}/members/{userId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the role of a member of an organization (organization owners). The last owner cannot be demoted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Change organization member role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID of the member",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.MemberRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.MemberResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or member not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Last owner",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a member from an organization and all its teams. Organization owners and admins may remove members, though only owners may remove owners; members may remove themselves to leave. The last owner cannot leave",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Remove organization member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID of the member",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or member not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Last owner of the organization or one of its teams",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{orgId}/teams": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the teams of an organization the signed-in user is a member of, with their role in each",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List teams",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.TeamResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a team in an organization, owned by the signed-in user (organization owners and admins)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Create team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Team",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.TeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.TeamResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{orgId}/teams/{teamId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a team of an organization the signed-in user is a member of",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Show team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "teamId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.TeamResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or team not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renames a team (team owners and admins)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Update team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "teamId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Team",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.TeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.TeamResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or team not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a team with its invitations (team owners)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Delete team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "teamId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or team not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{orgId}/teams/{teamId}/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the invitations to a team that were not answered yet, including expired ones (team owners and admins)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "List team invitations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "teamId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.InvitationResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or team not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Emails an invitation to join a team in the given role, \"member\" by default. Team owners and admins may invite, though only owners may invite owners. Replaces any pending invitation of the address to the team",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "Invite to team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "teamId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invitation",
+                        "name": "invitation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.InvitationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.InvitationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or team not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Already a member of the team",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{orgId}/teams/{teamId}/invitations/{invitationId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancels a pending invitation to a team, so its link stops working (team owners and admins)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "Cancel team invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "teamId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Invitation ID",
+                        "name": "invitationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization, team or invitation not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{orgId}/teams/{teamId}/members": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the members of a team of an organization the signed-in user is a member of, with their team roles",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List team members",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "teamId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.MemberResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a signed-in user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or team not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{orgId}/teams/{teamId}/members/{userId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the role of a member of a team. Team owners and admins may, though only owners may change the role of an owner or make a member owner. The last owner cannot be demoted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Change team member role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "teamId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID of the member",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.MemberRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.MemberResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization, team or member not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Last owner",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a member from a team; they stay a member of the organization. Team owners and admins may remove members, though only owners may remove owners; members may remove themselves to leave. The last owner cannot leave",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Remove team member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "orgId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "teamId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID of the member",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient role",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization, team or member not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Last owner",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/forgot": {
+            "post": {
+                "description": "Sends a password reset link if the address belongs to an account. Always responds with 202 so it can't be used to discover accounts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "password"
+                ],
+                "summary": "Request password reset",
+                "parameters": [
+                    {
+                        "description": "Email address",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/reset": {
+            "post": {
+                "description": "Sets a new password with the single-use token sent in the reset link. Tokens issued before the reset stop working",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "password"
+                ],
+                "summary": "Reset password",
+                "parameters": [
+                    {
+                        "description": "Reset token and new password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or token, or the password violates the password policy",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all users in the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List all users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates a new user record",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create new user",
+                "parameters": [
+                    {
+                        "description": "User information",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or user already exists",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a user by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Show user details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Updates a user by ID. Signed-in users other than administrators can only update themselves, and only administrators can change the email address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated user information",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or email already used",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope, another user's record, or email change by a non-administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a user by ID. Signed-in users other than administrators can only delete themselves",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope, another user's record, or impersonation",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/activate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Activates a pending or suspended user. Requires administrator privileges",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Activate user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional reason",
+                        "name": "reason",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed from the user's current status",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/deactivate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deactivates a user, who can then only be reactivated. Requires administrator privileges",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Deactivate user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional reason",
+                        "name": "reason",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed from the user's current status",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/reactivate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reactivates a deactivated user. Requires administrator privileges",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Reactivate user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional reason",
+                        "name": "reason",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed from the user's current status",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/suspend": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Suspends an active user. Suspended users cannot log in and their tokens are rejected. Requires administrator privileges",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Suspend user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional reason",
+                        "name": "reason",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/internal_interfaces_api.ResponseModel"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_internal_application_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient scope or not an administrator",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed from the user's current status",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_interfaces_api.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "internal_interfaces_api.ForgotPasswordRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_interfaces_api.ImpersonationResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "Expiry time (UTC)",
+                    "type": "string",
+                    "example": "2030-01-01T00:15:00Z"
+                },
+                "token": {
+                    "description": "Access token, sent as a Bearer token",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "Impersonated user",
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_interfaces_api.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "scope": {
+                    "description": "Optional space-separated scopes to limit the token to; all scopes if omitted",
+                    "type": "string",
+                    "example": "users:read"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_interfaces_api.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "mfa_required": {
+                    "type": "boolean"
+                },
+                "mfa_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "description": "Exchanged at /login/refresh for a new token; replaced on every use",
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_interfaces_api.MFACodeRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "description": "Authenticator code, or a recovery code where accepted",
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "internal_interfaces_api.MFALoginRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "mfa_token"
+            ],
+            "properties": {
+                "code": {
+                    "description": "Authenticator code or recovery code",
+                    "type": "string",
+                    "example": "123456"
+                },
+                "mfa_token": {
+                    "description": "Token returned by /login",
+                    "type": "string"
+                }
+            }
+        },
+        "internal_interfaces_api.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Stable error code, see pkg/errors",
+                    "type": "string"
+                },
+                "detail": {
+                    "description": "Explanation specific to this occurrence",
+                    "type": "string"
+                },
+                "errors": {
+                    "description": "Per-field validation failures",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcanvr_example-golang-api-with-fiber_pkg_errors.FieldViolation"
+                    }
+                },
+                "instance": {
+                    "description": "Request path where the problem occurred",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "HTTP status code",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Short, human-readable summary",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "URI reference identifying the problem type",
+                    "type": "string"
+                }
+            }
+        },
+        "internal_interfaces_api.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "description": "Refresh token returned by the last login or refresh",
+                    "type": "string"
+                }
+            }
+        },
+        "internal_interfaces_api.ResendVerificationRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_interfaces_api.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "description": "Checked against the password policy",
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_interfaces_api.ResponseModel": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "internal_interfaces_api.UnlockRequest": {
+            "type": "object",
+            "properties": {
+                "ip": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_interfaces_api.VerifyEmailRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.APIKeyCreatedResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Creation time (UTC)",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "Who created the key",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "Expiry time (UTC), if any",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Key's unique identifier",
+                    "type": "integer"
+                },
+                "key": {
+                    "description": "The full key, to send in the X-API-Key header",
+                    "type": "string",
+                    "example": "ak_1f0c9e2b7a4d.kq2J4pX0v9H8Zr6T1mWn3bQyLs5Dc7Fa"
+                },
+                "last_used_at": {
+                    "description": "Last use (UTC), if ever used",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "What the key is used for",
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "Start of the key, to recognize it",
+                    "type": "string",
+                    "example": "ak_1f0c9e2b7a4d"
+                },
+                "revoked_at": {
+                    "description": "Revocation time (UTC), if revoked",
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "Granted scopes",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "description": "active, expired or revoked",
+                    "type": "string",
+                    "example": "active"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.APIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "Optional expiry; the key never expires if omitted",
+                    "type": "string",
+                    "example": "2030-01-01T00:00:00Z"
+                },
+                "name": {
+                    "description": "What the key is used for",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "nightly-user-sync"
+                },
+                "scopes": {
+                    "description": "Granted scopes",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "users:read"
+                    ]
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Creation time (UTC)",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "Who created the key",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "Expiry time (UTC), if any",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Key's unique identifier",
+                    "type": "integer"
+                },
+                "last_used_at": {
+                    "description": "Last use (UTC), if ever used",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "What the key is used for",
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "Start of the key, to recognize it",
+                    "type": "string",
+                    "example": "ak_1f0c9e2b7a4d"
+                },
+                "revoked_at": {
+                    "description": "Revocation time (UTC), if revoked",
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "Granted scopes",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "description": "active, expired or revoked",
+                    "type": "string",
+                    "example": "active"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.InvitationRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "description": "Address to send the invitation to",
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "role": {
+                    "description": "Role in the team, \"member\" if omitted",
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "admin",
+                        "member"
+                    ],
+                    "example": "member"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.InvitationResponse": {
+            "type": "object",
+            "properties": {
+                "answered_at": {
+                    "description": "When the invitation was answered (UTC), if it was",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "When the invitation was sent (UTC)",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "Address the invitation was sent to",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "When the invitation link stops working (UTC)",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Invitation's unique identifier",
+                    "type": "integer"
+                },
+                "invited_by": {
+                    "description": "User who sent the invitation",
+                    "type": "integer"
+                },
+                "organization_id": {
+                    "description": "Organization of the team",
+                    "type": "integer"
+                },
+                "role": {
+                    "description": "Role the invitee gets in the team",
+                    "type": "string",
+                    "example": "member"
+                },
+                "status": {
+                    "description": "pending, accepted, declined or expired",
+                    "type": "string",
+                    "example": "pending"
+                },
+                "team_id": {
+                    "description": "Team the invitee is asked to join",
+                    "type": "integer"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.InvitationTokenRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "description": "Token from the invitation link",
+                    "type": "string"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.MFAEnrollmentResponse": {
+            "type": "object",
+            "properties": {
+                "provisioning_uri": {
+                    "description": "otpauth:// URI for authenticator apps",
+                    "type": "string",
+                    "example": "otpauth://totp/Example%20API:jane@example.com?issuer=Example+API\u0026secret=JBSWY3DP"
+                },
+                "secret": {
+                    "description": "Base32 TOTP secret",
+                    "type": "string",
+                    "example": "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.MFARecoveryCodesResponse": {
+            "type": "object",
+            "properties": {
+                "recovery_codes": {
+                    "description": "Single-use codes accepted in place of an authenticator code",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "k3v9q-2mx7d"
+                    ]
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.MemberResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Member's email address, empty if the user no longer exists",
+                    "type": "string"
+                },
+                "joined_at": {
+                    "description": "When the user joined (UTC)",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Member's display name, empty if the user no longer exists",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "owner, admin or member",
+                    "type": "string",
+                    "example": "member"
+                },
+                "user_id": {
+                    "description": "Member's user ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.MemberRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "description": "owner, admin or member",
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "admin",
+                        "member"
+                    ],
+                    "example": "admin"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthClientCreatedResponse": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "description": "Identifier the client authenticates with",
+                    "type": "string",
+                    "example": "client_4f2a9c1e7b3d8a05"
+                },
+                "client_secret": {
+                    "description": "The client secret, to authenticate at the token endpoint",
+                    "type": "string",
+                    "example": "Jx8vQ2mT5nR1wL7cZ4pK9sD3fH6gB0aY"
+                },
+                "created_at": {
+                    "description": "Registration time (UTC)",
                     "type": "string"
                 },
-                "success": {
+                "created_by": {
+                    "description": "Who registered the client",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Client's unique identifier",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "What the client is",
+                    "type": "string"
+                },
+                "refresh_tokens": {
+                    "description": "Whether the client is issued refresh tokens",
+                    "type": "boolean"
+                },
+                "scopes": {
+                    "description": "Scopes the client may request",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthClientRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "name": {
+                    "description": "What the client is",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "reporting-service"
+                },
+                "refresh_tokens": {
+                    "description": "Issue refresh tokens along with access tokens, off by default",
+                    "type": "boolean"
+                },
+                "scopes": {
+                    "description": "Scopes the client may request",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "users:read"
+                    ]
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthClientResponse": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "description": "Identifier the client authenticates with",
+                    "type": "string",
+                    "example": "client_4f2a9c1e7b3d8a05"
+                },
+                "created_at": {
+                    "description": "Registration time (UTC)",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "Who registered the client",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Client's unique identifier",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "What the client is",
+                    "type": "string"
+                },
+                "refresh_tokens": {
+                    "description": "Whether the client is issued refresh tokens",
+                    "type": "boolean"
+                },
+                "scopes": {
+                    "description": "Scopes the client may request",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Error code",
+                    "type": "string",
+                    "example": "invalid_client"
+                },
+                "error_description": {
+                    "description": "Explanation for the developer",
+                    "type": "string"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthIntrospectionResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Whether the token can be used",
+                    "type": "boolean"
+                },
+                "client_id": {
+                    "description": "Client the token was issued to",
+                    "type": "string"
+                },
+                "exp": {
+                    "description": "Expiry as a Unix timestamp",
+                    "type": "integer"
+                },
+                "iat": {
+                    "description": "Issue time as a Unix timestamp",
+                    "type": "integer"
+                },
+                "jti": {
+                    "description": "Token identifier",
+                    "type": "string"
+                },
+                "scope": {
+                    "description": "Space-separated scopes granted",
+                    "type": "string",
+                    "example": "users:read"
+                },
+                "sub": {
+                    "description": "User ID or client ID the token was issued to",
+                    "type": "string"
+                },
+                "token_type": {
+                    "description": "Bearer for access tokens",
+                    "type": "string",
+                    "example": "Bearer"
+                },
+                "username": {
+                    "description": "User the token was issued to",
+                    "type": "string"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.OAuthTokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "description": "JWT to send as a Bearer token",
+                    "type": "string"
+                },
+                "expires_in": {
+                    "description": "Lifetime of the access token in seconds",
+                    "type": "integer",
+                    "example": 900
+                },
+                "refresh_token": {
+                    "description": "Single-use token to obtain the next access token, for clients issued refresh tokens",
+                    "type": "string"
+                },
+                "scope": {
+                    "description": "Space-separated scopes granted",
+                    "type": "string",
+                    "example": "users:read"
+                },
+                "token_type": {
+                    "description": "Always Bearer",
+                    "type": "string",
+                    "example": "Bearer"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.OrganizationRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Organization's name",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Acme Corporation"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.OrganizationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Creation time (UTC)",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Organization's unique identifier",
+                    "type": "integer"
+                },
+                "member_count": {
+                    "description": "Number of members",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Organization's name",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "Caller's role in the organization: owner, admin or member",
+                    "type": "string",
+                    "example": "owner"
+                },
+                "updated_at": {
+                    "description": "Last change of the organization or its members (UTC)",
+                    "type": "string"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.PasswordChangeRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "description": "Checked against the password policy",
+                    "type": "string"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Sign-in time (UTC)",
+                    "type": "string"
+                },
+                "current": {
+                    "description": "Whether this is the session of the request",
                     "type": "boolean"
+                },
+                "expires_at": {
+                    "description": "When the session ends (UTC)",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Session's unique identifier",
+                    "type": "integer"
+                },
+                "ip": {
+                    "description": "Client IP address last seen",
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "last_seen_at": {
+                    "description": "Last use (UTC), updated at most once a minute",
+                    "type": "string"
+                },
+                "user_agent": {
+                    "description": "Client user agent last seen",
+                    "type": "string",
+                    "example": "Mozilla/5.0 (X11; Linux x86_64)"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.TeamRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Team's name",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Platform"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.TeamResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Creation time (UTC)",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Team's unique identifier",
+                    "type": "integer"
+                },
+                "member_count": {
+                    "description": "Number of members",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Team's name",
+                    "type": "string"
+                },
+                "organization_id": {
+                    "description": "Organization the team belongs to",
+                    "type": "integer"
+                },
+                "role": {
+                    "description": "Caller's role in the team, \"owner\" for organization owners and admins, empty if none",
+                    "type": "string",
+                    "example": "member"
+                },
+                "updated_at": {
+                    "description": "Last change of the team or its members (UTC)",
+                    "type": "string"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.UserPatchRequest": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "description": "New age",
+                    "type": "integer",
+                    "maximum": 120,
+                    "minimum": 0
+                },
+                "display_name": {
+                    "description": "New display name, empty to use the name",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "email": {
+                    "description": "New email address, to be verified again",
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "New locale",
+                    "type": "string",
+                    "example": "en-US"
+                },
+                "metadata": {
+                    "description": "Replaces all metadata if present",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "description": "New name",
+                    "type": "string",
+                    "minLength": 2
+                },
+                "phone_number": {
+                    "description": "New phone number in E.164 format",
+                    "type": "string",
+                    "example": "+14155552671"
+                },
+                "timezone": {
+                    "description": "New IANA time zone",
+                    "type": "string",
+                    "example": "Europe/Istanbul"
                 }
             }
         },
@@ -471,14 +5296,41 @@ const docTemplate = `{
                     "maximum": 120,
                     "minimum": 0
                 },
+                "display_name": {
+                    "description": "Optional display name, defaults to name",
+                    "type": "string",
+                    "maxLength": 100
+                },
                 "email": {
                     "description": "Email with format validation",
                     "type": "string"
                 },
+                "locale": {
+                    "description": "Optional locale, defaults to \"en\"",
+                    "type": "string",
+                    "example": "en-US"
+                },
+                "metadata": {
+                    "description": "Optional free-form key/value data",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "description": "Name with minimum length validation",
                     "type": "string",
                     "minLength": 2
+                },
+                "phone_number": {
+                    "description": "Optional phone number in E.164 format",
+                    "type": "string",
+                    "example": "+14155552671"
+                },
+                "timezone": {
+                    "description": "Optional IANA time zone, defaults to \"UTC\"",
+                    "type": "string",
+                    "example": "Europe/Istanbul"
                 }
             }
         },
@@ -489,27 +5341,112 @@ const docTemplate = `{
                     "description": "User's age",
                     "type": "integer"
                 },
+                "created_at": {
+                    "description": "Creation time (UTC)",
+                    "type": "string"
+                },
+                "display_name": {
+                    "description": "Name shown to other users",
+                    "type": "string"
+                },
                 "email": {
                     "description": "User's email address",
                     "type": "string"
                 },
+                "email_verified": {
+                    "description": "Whether the email address has been confirmed",
+                    "type": "boolean"
+                },
                 "id": {
                     "description": "User's unique identifier",
                     "type": "integer"
                 },
+                "locale": {
+                    "description": "Preferred locale (BCP 47)",
+                    "type": "string",
+                    "example": "en-US"
+                },
+                "metadata": {
+                    "description": "Free-form key/value data",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "description": "User's full name",
                     "type": "string"
+                },
+                "phone_number": {
+                    "description": "Phone number in E.164 format",
+                    "type": "string",
+                    "example": "+14155552671"
+                },
+                "status": {
+                    "description": "Lifecycle state: pending, active, suspended or deactivated",
+                    "type": "string",
+                    "example": "active"
+                },
+                "tenant_id": {
+                    "description": "Tenant the user belongs to",
+                    "type": "string",
+                    "example": "default"
+                },
+                "timezone": {
+                    "description": "IANA time zone",
+                    "type": "string",
+                    "example": "Europe/Istanbul"
+                },
+                "updated_at": {
+                    "description": "Last modification time (UTC)",
+                    "type": "string"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_internal_application_dto.UserStatusRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "Optional explanation recorded with the transition",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Chargeback under investigation"
+                }
+            }
+        },
+        "mcanvr_example-golang-api-with-fiber_pkg_errors.FieldViolation": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Validation rule that failed (e.g. \"required\", \"email\")",
+                    "type": "string"
+                },
+                "field": {
+                    "description": "Name of the field as sent by the client",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "Human-readable description of the failure",
+                    "type": "string"
                 }
             }
         }
     },
     "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "API key for service-to-service callers, accepted by the user endpoints according to its scopes",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "BearerAuth": {
             "description": "JWT Authorization header using the Bearer scheme. Example: \"Bearer {token}\"",
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
+        },
+        "ClientAuth": {
+            "type": "basic"
         }
     },
     "security": [
@@ -526,7 +5463,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/api/v1",
 	Schemes:          []string{"http", "https"},
 	Title:            "Example Fiber API with DDD",
-	Description:      "This is an example REST API project using Domain-Driven Design principles with Fiber framework",
+	Description:      "OAuth client ID and secret, for the /oauth endpoints",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"time"
+)
+
+// API key statuses reported in APIKeyResponse.Status
+const (
+	APIKeyStatusActive  = "active"
+	APIKeyStatusExpired = "expired"
+	APIKeyStatusRevoked = "revoked"
+)
+
+// APIKeyRequest describes an API key to create.
+type APIKeyRequest struct {
+	Name      string     `json:"name" validate:"required,max=100" example:"nightly-user-sync"`                      // What the key is used for
+	Scopes    []string   `json:"scopes" validate:"required,dive,oneof=users:read users:write" example:"users:read"` // Granted scopes
+	ExpiresAt *time.Time `json:"expires_at" example:"2030-01-01T00:00:00Z"`                                         // Optional expiry; the key never expires if omitted
+}
+
+// APIKeyResponse describes an API key without its secret.
+type APIKeyResponse struct {
+	ID         int        `json:"id"`                               // Key's unique identifier
+	Name       string     `json:"name"`                             // What the key is used for
+	Prefix     string     `json:"prefix" example:"ak_1f0c9e2b7a4d"` // Start of the key, to recognize it
+	Scopes     []string   `json:"scopes"`                           // Granted scopes
+	Status     string     `json:"status" example:"active"`          // active, expired or revoked
+	CreatedBy  string     `json:"created_by"`                       // Who created the key
+	CreatedAt  time.Time  `json:"created_at"`                       // Creation time (UTC)
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`             // Expiry time (UTC), if any
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`           // Last use (UTC), if ever used
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`             // Revocation time (UTC), if revoked
+}
+
+// APIKeyCreatedResponse describes a new API key including the key itself,
+// which is only returned once.
+type APIKeyCreatedResponse struct {
+	APIKeyResponse
+	Key string `json:"key" example:"ak_1f0c9e2b7a4d.kq2J4pX0v9H8Zr6T1mWn3bQyLs5Dc7Fa"` // The full key, to send in the X-API-Key header
+}
+
+// ToAPIKeyResponse converts a domain API key to a response DTO.
+func ToAPIKeyResponse(key *model.APIKey, now time.Time) APIKeyResponse {
+	status := APIKeyStatusActive
+	switch {
+	case key.IsRevoked():
+		status = APIKeyStatusRevoked
+	case key.IsExpired(now):
+		status = APIKeyStatusExpired
+	}
+
+	return APIKeyResponse{
+		ID:         key.ID(),
+		Name:       key.Name(),
+		Prefix:     key.Prefix(),
+		Scopes:     key.Scopes(),
+		Status:     status,
+		CreatedBy:  key.CreatedBy(),
+		CreatedAt:  key.CreatedAt().UTC(),
+		ExpiresAt:  optionalTime(key.ExpiresAt()),
+		LastUsedAt: optionalTime(key.LastUsedAt()),
+		RevokedAt:  optionalTime(key.RevokedAt()),
+	}
+}
+
+// optionalTime returns t in UTC, or nil if it is the zero time
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
@@ -0,0 +1,14 @@
+package dto
+
+// MFAEnrollmentResponse carries the secret of a new authenticator to the client.
+// The provisioning URI is meant to be shown as a QR code; the secret can be typed
+// in by hand when scanning is not possible.
+type MFAEnrollmentResponse struct {
+	Secret          string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`                                                           // Base32 TOTP secret
+	ProvisioningURI string `json:"provisioning_uri" example:"otpauth://totp/Example%20API:jane@example.com?issuer=Example+API&secret=JBSWY3DP"` // otpauth:// URI for authenticator apps
+}
+
+// MFARecoveryCodesResponse carries recovery codes, which are shown only once.
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes" example:"k3v9q-2mx7d"` // Single-use codes accepted in place of an authenticator code
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"time"
+)
+
+// OAuthClientRequest describes an OAuth client to register.
+type OAuthClientRequest struct {
+	Name          string   `json:"name" validate:"required,max=100" example:"reporting-service"`                                        // What the client is
+	Scopes        []string `json:"scopes" validate:"required,dive,oneof=users:read users:write tokens:introspect" example:"users:read"` // Scopes the client may request
+	RefreshTokens bool     `json:"refresh_tokens"`                                                                                      // Issue refresh tokens along with access tokens, off by default
+}
+
+// OAuthClientResponse describes an OAuth client without its secret.
+type OAuthClientResponse struct {
+	ID            int       `json:"id"`                                          // Client's unique identifier
+	ClientID      string    `json:"client_id" example:"client_4f2a9c1e7b3d8a05"` // Identifier the client authenticates with
+	Name          string    `json:"name"`                                        // What the client is
+	Scopes        []string  `json:"scopes"`                                      // Scopes the client may request
+	RefreshTokens bool      `json:"refresh_tokens"`                              // Whether the client is issued refresh tokens
+	CreatedBy     string    `json:"created_by"`                                  // Who registered the client
+	CreatedAt     time.Time `json:"created_at"`                                  // Registration time (UTC)
+}
+
+// OAuthClientCreatedResponse describes a new OAuth client including its secret,
+// which is only returned once.
+type OAuthClientCreatedResponse struct {
+	OAuthClientResponse
+	ClientSecret string `json:"client_secret" example:"Jx8vQ2mT5nR1wL7cZ4pK9sD3fH6gB0aY"` // The client secret, to authenticate at the token endpoint
+}
+
+// ToOAuthClientResponse converts a domain OAuth client to a response DTO.
+func ToOAuthClientResponse(client *model.OAuthClient) OAuthClientResponse {
+	return OAuthClientResponse{
+		ID:            client.ID(),
+		ClientID:      client.ClientID(),
+		Name:          client.Name(),
+		Scopes:        client.Scopes(),
+		RefreshTokens: client.IssuesRefreshTokens(),
+		CreatedBy:     client.CreatedBy(),
+		CreatedAt:     client.CreatedAt().UTC(),
+	}
+}
+
+// OAuthTokenRequest holds the form parameters of a token request (RFC 6749).
+// The client authenticates separately, see the token endpoint.
+type OAuthTokenRequest struct {
+	GrantType    string // client_credentials or refresh_token
+	Scope        string // Optional space-separated scopes, narrowing those granted
+	RefreshToken string // Refresh token of the refresh_token grant
+}
+
+// OAuthTokenResponse is a successful token response (RFC 6749 section 5.1).
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`                // JWT to send as a Bearer token
+	TokenType    string `json:"token_type" example:"Bearer"` // Always Bearer
+	ExpiresIn    int    `json:"expires_in" example:"900"`    // Lifetime of the access token in seconds
+	RefreshToken string `json:"refresh_token,omitempty"`     // Single-use token to obtain the next access token, for clients issued refresh tokens
+	Scope        string `json:"scope" example:"users:read"`  // Space-separated scopes granted
+}
+
+// OAuthIntrospectionResponse describes a token (RFC 7662 section 2.2).
+// Only Active is set for tokens that are invalid, expired or revoked.
+type OAuthIntrospectionResponse struct {
+	Active    bool   `json:"active"`                                // Whether the token can be used
+	Scope     string `json:"scope,omitempty" example:"users:read"`  // Space-separated scopes granted
+	ClientID  string `json:"client_id,omitempty"`                   // Client the token was issued to
+	Username  string `json:"username,omitempty"`                    // User the token was issued to
+	TokenType string `json:"token_type,omitempty" example:"Bearer"` // Bearer for access tokens
+	Exp       int64  `json:"exp,omitempty"`                         // Expiry as a Unix timestamp
+	Iat       int64  `json:"iat,omitempty"`                         // Issue time as a Unix timestamp
+	Sub       string `json:"sub,omitempty"`                         // User ID or client ID the token was issued to
+	Jti       string `json:"jti,omitempty"`                         // Token identifier
+}
+
+// OAuthErrorResponse is an error response of the OAuth endpoints (RFC 6749 section 5.2).
+type OAuthErrorResponse struct {
+	Error            string `json:"error" example:"invalid_client"` // Error code
+	ErrorDescription string `json:"error_description,omitempty"`    // Explanation for the developer
+}
@@ -0,0 +1,141 @@
+package dto
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"time"
+)
+
+// OrganizationRequest describes an organization to create or rename.
+type OrganizationRequest struct {
+	Name string `json:"name" validate:"required,max=100" example:"Acme Corporation"` // Organization's name
+}
+
+// OrganizationResponse describes an organization as seen by one of its members.
+type OrganizationResponse struct {
+	ID          int       `json:"id"`                   // Organization's unique identifier
+	Name        string    `json:"name"`                 // Organization's name
+	Role        string    `json:"role" example:"owner"` // Caller's role in the organization: owner, admin or member
+	MemberCount int       `json:"member_count"`         // Number of members
+	CreatedAt   time.Time `json:"created_at"`           // Creation time (UTC)
+	UpdatedAt   time.Time `json:"updated_at"`           // Last change of the organization or its members (UTC)
+}
+
+// ToOrganizationResponse converts a domain organization to a response DTO for the member with the given role.
+func ToOrganizationResponse(organization *model.Organization, role model.MemberRole) OrganizationResponse {
+	return OrganizationResponse{
+		ID:          organization.ID(),
+		Name:        organization.Name(),
+		Role:        string(role),
+		MemberCount: len(organization.Members()),
+		CreatedAt:   organization.CreatedAt().UTC(),
+		UpdatedAt:   organization.UpdatedAt().UTC(),
+	}
+}
+
+// TeamRequest describes a team to create or rename.
+type TeamRequest struct {
+	Name string `json:"name" validate:"required,max=100" example:"Platform"` // Team's name
+}
+
+// TeamResponse describes a team of an organization.
+type TeamResponse struct {
+	ID             int       `json:"id"`                              // Team's unique identifier
+	OrganizationID int       `json:"organization_id"`                 // Organization the team belongs to
+	Name           string    `json:"name"`                            // Team's name
+	Role           string    `json:"role,omitempty" example:"member"` // Caller's role in the team, "owner" for organization owners and admins, empty if none
+	MemberCount    int       `json:"member_count"`                    // Number of members
+	CreatedAt      time.Time `json:"created_at"`                      // Creation time (UTC)
+	UpdatedAt      time.Time `json:"updated_at"`                      // Last change of the team or its members (UTC)
+}
+
+// ToTeamResponse converts a domain team to a response DTO for a caller with
+// the given role in it, empty if they have none.
+func ToTeamResponse(team *model.Team, role model.MemberRole) TeamResponse {
+	return TeamResponse{
+		ID:             team.ID(),
+		OrganizationID: team.OrganizationID(),
+		Name:           team.Name(),
+		Role:           string(role),
+		MemberCount:    len(team.Members()),
+		CreatedAt:      team.CreatedAt().UTC(),
+		UpdatedAt:      team.UpdatedAt().UTC(),
+	}
+}
+
+// MemberRoleRequest describes the new role of a member.
+type MemberRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=owner admin member" example:"admin"` // owner, admin or member
+}
+
+// MemberResponse describes a member of an organization or team.
+type MemberResponse struct {
+	UserID   int       `json:"user_id"`               // Member's user ID
+	Name     string    `json:"name,omitempty"`        // Member's display name, empty if the user no longer exists
+	Email    string    `json:"email,omitempty"`       // Member's email address, empty if the user no longer exists
+	Role     string    `json:"role" example:"member"` // owner, admin or member
+	JoinedAt time.Time `json:"joined_at"`             // When the user joined (UTC)
+}
+
+// ToMemberResponse converts a membership to a response DTO, describing the
+// member with their user if it still exists.
+func ToMemberResponse(member model.Member, user *model.User) MemberResponse {
+	response := MemberResponse{
+		UserID:   member.UserID,
+		Role:     string(member.Role),
+		JoinedAt: member.JoinedAt.UTC(),
+	}
+	if user != nil {
+		response.Name = user.DisplayName()
+		response.Email = user.Email().String()
+	}
+	return response
+}
+
+// InvitationRequest describes an invitation to a team.
+type InvitationRequest struct {
+	Email string `json:"email" validate:"required,email" example:"jane@example.com"`          // Address to send the invitation to
+	Role  string `json:"role" validate:"omitempty,oneof=owner admin member" example:"member"` // Role in the team, "member" if omitted
+}
+
+// InvitationTokenRequest carries the token of an invitation link.
+type InvitationTokenRequest struct {
+	Token string `json:"token" validate:"required"` // Token from the invitation link
+}
+
+// InvitationResponse describes an invitation to a team, without its token.
+type InvitationResponse struct {
+	ID             int        `json:"id"`                       // Invitation's unique identifier
+	OrganizationID int        `json:"organization_id"`          // Organization of the team
+	TeamID         int        `json:"team_id"`                  // Team the invitee is asked to join
+	Email          string     `json:"email"`                    // Address the invitation was sent to
+	Role           string     `json:"role" example:"member"`    // Role the invitee gets in the team
+	Status         string     `json:"status" example:"pending"` // pending, accepted, declined or expired
+	InvitedBy      int        `json:"invited_by"`               // User who sent the invitation
+	CreatedAt      time.Time  `json:"created_at"`               // When the invitation was sent (UTC)
+	ExpiresAt      time.Time  `json:"expires_at"`               // When the invitation link stops working (UTC)
+	AnsweredAt     *time.Time `json:"answered_at,omitempty"`    // When the invitation was answered (UTC), if it was
+}
+
+// InvitationStatusExpired is reported in InvitationResponse.Status for pending invitations past their expiry
+const InvitationStatusExpired = "expired"
+
+// ToInvitationResponse converts a domain invitation to a response DTO, as of now.
+func ToInvitationResponse(invitation *model.Invitation, now time.Time) InvitationResponse {
+	status := string(invitation.Status())
+	if invitation.Status() == model.InvitationPending && !invitation.IsOpen(now) {
+		status = InvitationStatusExpired
+	}
+
+	return InvitationResponse{
+		ID:             invitation.ID(),
+		OrganizationID: invitation.OrganizationID(),
+		TeamID:         invitation.TeamID(),
+		Email:          invitation.Email().String(),
+		Role:           string(invitation.Role()),
+		Status:         status,
+		InvitedBy:      invitation.InvitedBy(),
+		CreatedAt:      invitation.CreatedAt().UTC(),
+		ExpiresAt:      invitation.ExpiresAt().UTC(),
+		AnsweredAt:     optionalTime(invitation.AnsweredAt()),
+	}
+}
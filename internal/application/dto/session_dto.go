@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"time"
+)
+
+// SessionResponse describes a device a user is signed in on.
+type SessionResponse struct {
+	ID         int       `json:"id"`                                                   // Session's unique identifier
+	IP         string    `json:"ip" example:"203.0.113.7"`                             // Client IP address last seen
+	UserAgent  string    `json:"user_agent" example:"Mozilla/5.0 (X11; Linux x86_64)"` // Client user agent last seen
+	CreatedAt  time.Time `json:"created_at"`                                           // Sign-in time (UTC)
+	LastSeenAt time.Time `json:"last_seen_at"`                                         // Last use (UTC), updated at most once a minute
+	ExpiresAt  time.Time `json:"expires_at"`                                           // When the session ends (UTC)
+	Current    bool      `json:"current"`                                              // Whether this is the session of the request
+}
+
+// ToSessionResponse converts a domain session to a response DTO.
+func ToSessionResponse(session *model.Session, current bool) SessionResponse {
+	return SessionResponse{
+		ID:         session.ID(),
+		IP:         session.IP(),
+		UserAgent:  session.UserAgent(),
+		CreatedAt:  session.CreatedAt().UTC(),
+		LastSeenAt: session.LastSeenAt().UTC(),
+		ExpiresAt:  session.ExpiresAt().UTC(),
+		Current:    current,
+	}
+}
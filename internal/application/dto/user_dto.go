@@ -1,31 +1,78 @@
 package dto
 
-import "mcanvr/example-golang-api-with-fiber/internal/domain/model"
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"time"
+)
 
 // UserResponse represents the data structure returned to API clients.
 // It translates domain entities to client-friendly format.
 type UserResponse struct {
-	ID    int    `json:"id"`    // User's unique identifier
-	Name  string `json:"name"`  // User's full name
-	Email string `json:"email"` // User's email address
-	Age   int    `json:"age"`   // User's age
+	ID            int               `json:"id"`                                            // User's unique identifier
+	TenantID      string            `json:"tenant_id" example:"default"`                   // Tenant the user belongs to
+	Name          string            `json:"name"`                                          // User's full name
+	DisplayName   string            `json:"display_name"`                                  // Name shown to other users
+	Email         string            `json:"email"`                                         // User's email address
+	EmailVerified bool              `json:"email_verified"`                                // Whether the email address has been confirmed
+	PhoneNumber   string            `json:"phone_number,omitempty" example:"+14155552671"` // Phone number in E.164 format
+	Age           int               `json:"age"`                                           // User's age
+	Locale        string            `json:"locale" example:"en-US"`                        // Preferred locale (BCP 47)
+	Timezone      string            `json:"timezone" example:"Europe/Istanbul"`            // IANA time zone
+	Metadata      map[string]string `json:"metadata,omitempty"`                            // Free-form key/value data
+	Status        string            `json:"status" example:"active"`                       // Lifecycle state: pending, active, suspended or deactivated
+	CreatedAt     time.Time         `json:"created_at"`                                    // Creation time (UTC)
+	UpdatedAt     time.Time         `json:"updated_at"`                                    // Last modification time (UTC)
 }
 
 // UserRequest represents the expected input structure for user creation/update.
 // It defines validation rules for incoming API data.
 type UserRequest struct {
-	Name  string `json:"name" validate:"required,min=2"`  // Name with minimum length validation
-	Email string `json:"email" validate:"required,email"` // Email with format validation
-	Age   int    `json:"age" validate:"gte=0,lte=120"`    // Age range validation
+	Name        string            `json:"name" validate:"required,min=2"`                                   // Name with minimum length validation
+	DisplayName string            `json:"display_name" validate:"max=100"`                                  // Optional display name, defaults to name
+	Email       string            `json:"email" validate:"required,email"`                                  // Email with format validation
+	PhoneNumber string            `json:"phone_number" validate:"omitempty,e164" example:"+14155552671"`    // Optional phone number in E.164 format
+	Age         int               `json:"age" validate:"gte=0,lte=120"`                                     // Age range validation
+	Locale      string            `json:"locale" validate:"omitempty,bcp47_language_tag" example:"en-US"`   // Optional locale, defaults to "en"
+	Timezone    string            `json:"timezone" validate:"omitempty,timezone" example:"Europe/Istanbul"` // Optional IANA time zone, defaults to "UTC"
+	Metadata    map[string]string `json:"metadata"`                                                         // Optional free-form key/value data
+}
+
+// UserPatchRequest represents a partial update of a user's own profile.
+// Omitted fields are left unchanged.
+type UserPatchRequest struct {
+	Name        *string           `json:"name" validate:"omitempty,min=2"`                                  // New name
+	DisplayName *string           `json:"display_name" validate:"omitempty,max=100"`                        // New display name, empty to use the name
+	Email       *string           `json:"email" validate:"omitempty,email"`                                 // New email address, to be verified again
+	PhoneNumber *string           `json:"phone_number" validate:"omitempty,e164" example:"+14155552671"`    // New phone number in E.164 format
+	Age         *int              `json:"age" validate:"omitempty,gte=0,lte=120"`                           // New age
+	Locale      *string           `json:"locale" validate:"omitempty,bcp47_language_tag" example:"en-US"`   // New locale
+	Timezone    *string           `json:"timezone" validate:"omitempty,timezone" example:"Europe/Istanbul"` // New IANA time zone
+	Metadata    map[string]string `json:"metadata"`                                                         // Replaces all metadata if present
+}
+
+// PasswordChangeRequest represents a user changing their own password.
+type PasswordChangeRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required"` // Checked against the password policy
 }
 
 // ToUserResponse converts a domain user model to a response DTO.
 func ToUserResponse(user *model.User) UserResponse {
 	return UserResponse{
-		ID:    user.ID(),
-		Name:  user.Name(),
-		Email: user.Email(),
-		Age:   user.Age(),
+		ID:            user.ID(),
+		TenantID:      user.TenantID(),
+		Name:          user.Name(),
+		DisplayName:   user.DisplayName(),
+		Email:         user.Email().String(),
+		EmailVerified: user.EmailVerified(),
+		PhoneNumber:   user.PhoneNumber().String(),
+		Age:           user.Age().Int(),
+		Locale:        user.Locale(),
+		Timezone:      user.Timezone(),
+		Metadata:      user.Metadata(),
+		Status:        string(user.Status()),
+		CreatedAt:     user.CreatedAt(),
+		UpdatedAt:     user.UpdatedAt(),
 	}
 }
 
@@ -37,3 +84,8 @@ func ToUserResponseList(users []*model.User) []UserResponse {
 	}
 	return result
 }
+
+// UserStatusRequest represents the optional input of a user status transition.
+type UserStatusRequest struct {
+	Reason string `json:"reason" validate:"max=500" example:"Chargeback under investigation"` // Optional explanation recorded with the transition
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidAPIKey is returned when an API key is malformed, unknown, expired or revoked
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// Register how the API key errors are reported to API clients
+func init() {
+	appErrors.Register(ErrInvalidAPIKey, appErrors.Mapping{
+		Status: http.StatusUnauthorized,
+		Code:   appErrors.CodeInvalidAPIKey,
+		Title:  constants.UnauthorizedAccess,
+		Detail: constants.InvalidAPIKeyDetail,
+	})
+	appErrors.Register(model.ErrInvalidAPIKeyData, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidRequest,
+		Title:  constants.InvalidRequestFormat,
+	})
+	appErrors.Register(model.ErrInvalidScope, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidScope,
+		Title:  constants.InvalidRequestFormat,
+	})
+	appErrors.Register(model.ErrAPIKeyRevoked, appErrors.Mapping{
+		Status: http.StatusConflict,
+		Code:   appErrors.CodeAPIKeyRevoked,
+		Title:  constants.APIKeyAlreadyRevoked,
+	})
+}
+
+// Audit actions of the API key service
+const (
+	AuditAPIKeyCreated = "api_key.created"
+	AuditAPIKeyRevoked = "api_key.revoked"
+)
+
+// apiKeyPrefix starts every API key, so leaked keys are easy to recognize
+const apiKeyPrefix = "ak_"
+
+// apiKeyUseInterval is how often the last-used time of a key is updated,
+// so busy keys don't cause a write on every request
+const apiKeyUseInterval = time.Minute
+
+// APIKeyService manages API keys and authenticates requests made with them.
+// A key is "<prefix>.<secret>": the prefix identifies the key and is stored as
+// is, while only a SHA-256 hash of the secret is stored.
+type APIKeyService struct {
+	apiKeyRepo   repository.APIKeyRepository
+	auditService *AuditService
+}
+
+// NewAPIKeyService creates a new API key service
+func NewAPIKeyService(apiKeyRepo repository.APIKeyRepository, auditService *AuditService) *APIKeyService {
+	return &APIKeyService{
+		apiKeyRepo:   apiKeyRepo,
+		auditService: auditService,
+	}
+}
+
+// Create issues a new API key for the tenant of ctx. The returned key is shown only once.
+// It cannot be granted a scope the caller creating it does not hold.
+func (s *APIKeyService) Create(ctx context.Context, request dto.APIKeyRequest, actor, clientIP string) (*dto.APIKeyCreatedResponse, error) {
+	tenantID, err := model.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHeldScopes(ctx, request.Scopes); err != nil {
+		return nil, err
+	}
+
+	prefix, secret, err := newAPIKeySecret()
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt time.Time
+	if request.ExpiresAt != nil {
+		expiresAt = *request.ExpiresAt
+	}
+
+	now := time.Now()
+	key, err := model.NewAPIKey(tenantID, request.Name, prefix, hashToken(secret), request.Scopes, actor, now, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.apiKeyRepo.Save(ctx, key); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditAPIKeyCreated, actor, apiKeySubject(key.ID()), clientIP, map[string]string{
+		"name":   key.Name(),
+		"prefix": key.Prefix(),
+		"scopes": strings.Join(key.Scopes(), " "),
+	})
+
+	return &dto.APIKeyCreatedResponse{
+		APIKeyResponse: dto.ToAPIKeyResponse(key, now),
+		Key:            prefix + "." + secret,
+	}, nil
+}
+
+// List returns every API key of the tenant of ctx, without their secrets.
+func (s *APIKeyService) List(ctx context.Context) ([]dto.APIKeyResponse, error) {
+	keys, err := s.apiKeyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	now := time.Now()
+	responses := make([]dto.APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		if CheckTenant(ctx, key.TenantID()) == nil {
+			responses = append(responses, dto.ToAPIKeyResponse(key, now))
+		}
+	}
+	return responses, nil
+}
+
+// Revoke permanently disables an API key.
+func (s *APIKeyService) Revoke(ctx context.Context, id int, actor, clientIP string) (*dto.APIKeyResponse, error) {
+	key, err := s.apiKeyRepo.FindByID(ctx, id)
+	if err != nil || CheckTenant(ctx, key.TenantID()) != nil {
+		return nil, &appErrors.ErrNotFound{Resource: "API key", ID: id}
+	}
+
+	now := time.Now()
+	if err := key.Revoke(now); err != nil {
+		return nil, err
+	}
+	if err := s.apiKeyRepo.Save(ctx, key); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditAPIKeyRevoked, actor, apiKeySubject(key.ID()), clientIP, map[string]string{
+		"prefix": key.Prefix(),
+	})
+
+	response := dto.ToAPIKeyResponse(key, now)
+	return &response, nil
+}
+
+// Authenticate returns the active API key matching a key sent by a client,
+// recording that it was used. It returns ErrInvalidAPIKey for malformed,
+// unknown, expired and revoked keys alike, and ErrTenantMismatch for keys of
+// another tenant than ctx.
+func (s *APIKeyService) Authenticate(ctx context.Context, rawKey string) (*model.APIKey, error) {
+	prefix, secret, ok := strings.Cut(rawKey, ".")
+	if !ok || !strings.HasPrefix(prefix, apiKeyPrefix) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	key, err := s.apiKeyRepo.FindByPrefix(ctx, prefix)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	hash := hashToken(secret)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(key.SecretHash())) != 1 || !key.IsActive(now) {
+		return nil, ErrInvalidAPIKey
+	}
+	if err := CheckTenant(ctx, key.TenantID()); err != nil {
+		return nil, err
+	}
+
+	if now.Sub(key.LastUsedAt()) >= apiKeyUseInterval {
+		key.MarkUsed(now)
+		if err := s.apiKeyRepo.MarkUsed(ctx, key.ID(), now); err != nil {
+			logger.Error(constants.APIKeyUseSaveFailed, key.Prefix(), err)
+		}
+	}
+	return key, nil
+}
+
+// newAPIKeySecret returns the random prefix and secret of a new API key
+func newAPIKeySecret() (string, string, error) {
+	id, err := randomToken(6, hex.EncodeToString)
+	if err != nil {
+		return "", "", err
+	}
+	secret, err := randomToken(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return "", "", err
+	}
+	return apiKeyPrefix + id, secret, nil
+}
+
+// apiKeySubject returns the audit subject identifying an API key
+func apiKeySubject(id int) string {
+	return "api_key:" + strconv.Itoa(id)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+)
+
+// interleavingAPIKeyRepository runs afterFind once, right after a key is looked
+// up by its prefix, to interleave a change with the caller's use of the copy
+type interleavingAPIKeyRepository struct {
+	repository.APIKeyRepository
+	afterFind func()
+}
+
+func (r *interleavingAPIKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	key, err := r.APIKeyRepository.FindByPrefix(ctx, prefix)
+	if afterFind := r.afterFind; afterFind != nil {
+		r.afterFind = nil
+		afterFind()
+	}
+	return key, err
+}
+
+func TestAuthenticateKeepsConcurrentRevocation(t *testing.T) {
+	ctx := adminContext()
+	repo := &interleavingAPIKeyRepository{APIKeyRepository: inmemory.NewInMemoryAPIKeyRepository()}
+	service := NewAPIKeyService(repo, NewAuditService(inmemory.NewInMemoryAuditRepository()))
+
+	created, err := service.Create(ctx, dto.APIKeyRequest{Name: "sync", Scopes: []string{"users:read"}}, "admin", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+
+	// The key is revoked while its first use is being recorded
+	repo.afterFind = func() {
+		if _, err := service.Revoke(ctx, created.ID, "admin", "127.0.0.1"); err != nil {
+			t.Fatalf("Revoke() unexpected error = %v", err)
+		}
+	}
+	if _, err := service.Authenticate(ctx, created.Key); err != nil {
+		t.Fatalf("Authenticate() unexpected error = %v", err)
+	}
+
+	if _, err := service.Authenticate(ctx, created.Key); !errors.Is(err, ErrInvalidAPIKey) {
+		t.Errorf("Authenticate() after revocation error = %v, want %v", err, ErrInvalidAPIKey)
+	}
+}
+
+func TestCreateLimitedToCallerScopes(t *testing.T) {
+	readOnly := WithPrincipal(testContext(), &Principal{UserID: adminID, Username: "admin", Admin: true, Scopes: []string{model.ScopeUsersRead}})
+
+	tests := []struct {
+		name    string
+		scopes  []string
+		wantErr error
+	}{
+		{"Scope Held", []string{model.ScopeUsersRead}, nil},
+		{"Scope Not Held", []string{model.ScopeUsersRead, model.ScopeUsersWrite}, ErrScopeNotHeld},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			apiKeys := NewAPIKeyService(inmemory.NewInMemoryAPIKeyRepository(), env.audit)
+			oauth := newTestOAuthService(env)
+
+			if _, err := apiKeys.Create(readOnly, dto.APIKeyRequest{Name: "sync", Scopes: tt.scopes}, "admin", "127.0.0.1"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+			if _, err := oauth.CreateClient(readOnly, dto.OAuthClientRequest{Name: "reports", Scopes: tt.scopes}, "admin", "127.0.0.1"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CreateClient() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Client-only scopes are not held by users and are left to the admin-only route
+	oauth := newTestOAuthService(newTestEnv(t))
+	if _, err := oauth.CreateClient(readOnly, dto.OAuthClientRequest{Name: "gateway", Scopes: []string{model.ScopeTokensIntrospect}}, "admin", "127.0.0.1"); err != nil {
+		t.Errorf("CreateClient() introspection client unexpected error = %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/event"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+)
+
+// Audit actions
+const (
+	AuditLoginLockout = "login.lockout"
+	AuditLoginUnlock  = "login.unlock"
+	AuditUserStatus   = "user.status_changed"
+)
+
+// AuditService records security-relevant events in the audit log
+type AuditService struct {
+	auditRepo repository.AuditRepository
+}
+
+// NewAuditService creates a new audit service
+func NewAuditService(auditRepo repository.AuditRepository) *AuditService {
+	return &AuditService{
+		auditRepo: auditRepo,
+	}
+}
+
+// Record stores an audit event and writes it to the application log.
+// Failures are logged rather than returned so auditing never blocks the audited action.
+func (s *AuditService) Record(ctx context.Context, action, actor, subject, ip string, details map[string]string) {
+	event := model.NewAuditEvent(action, actor, subject, ip, details)
+
+	logger.Info(constants.AuditEventLog, event.Action(), event.Actor(), event.Subject(), event.IP(), event.Details())
+	if err := s.auditRepo.Append(context.WithoutCancel(ctx), event); err != nil {
+		logger.Error(constants.AuditRecordFailed, event.Action(), err)
+	}
+}
+
+// HandleUserStatusChanged records user lifecycle transitions.
+// It is subscribed to model.UserStatusChanged events.
+func (s *AuditService) HandleUserStatusChanged(ctx context.Context, e event.Event) error {
+	changed, ok := e.(model.UserStatusChanged)
+	if !ok {
+		return fmt.Errorf("unexpected event type %T", e)
+	}
+
+	details := map[string]string{
+		"from": string(changed.From),
+		"to":   string(changed.To),
+	}
+	if changed.Reason != "" {
+		details["reason"] = changed.Reason
+	}
+
+	s.Record(ctx, AuditUserStatus, changed.Actor, userSubject(changed.UserID), "", details)
+	return nil
+}
@@ -1,50 +1,477 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
 	"mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// Authentication errors
+var (
+	ErrInvalidCredentials = errors.New(constants.InvalidCredentials)          // The username and password pair is wrong
+	ErrAccountDisabled    = errors.New("account is suspended or deactivated") // The account may not sign in
+	ErrTokenRevoked       = errors.New("token has been revoked")              // The token was issued before the password changed
+)
+
+// Register how the authentication errors are reported to API clients
+func init() {
+	appErrors.Register(ErrInvalidCredentials, appErrors.Mapping{
+		Status: http.StatusUnauthorized,
+		Code:   appErrors.CodeInvalidCredentials,
+	})
+	appErrors.Register(ErrAccountDisabled, appErrors.Mapping{
+		Status: http.StatusForbidden,
+		Code:   appErrors.CodeAccountDisabled,
+		Title:  constants.AccountDisabled,
+		Detail: constants.AccountDisabledDetail,
+	})
+	appErrors.Register(model.ErrScopeNotGranted, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidScope,
+		Title:  constants.InvalidRequestFormat,
+		Detail: constants.ScopeNotGrantedDetail,
+	})
+	appErrors.Register(ErrTokenRevoked, appErrors.Mapping{
+		Status: http.StatusUnauthorized,
+		Code:   appErrors.CodeInvalidToken,
+		Title:  constants.UnauthorizedAccess,
+		Detail: constants.TokenRevoked,
+	})
+}
+
+// LoginProtection configures brute-force protection for logins.
+// Failures are tracked separately per account and per client IP address,
+// so both targeted guessing and password spraying are throttled.
+type LoginProtection struct {
+	Account model.LockoutPolicy // Policy applied per username
+	IP      model.LockoutPolicy // Policy applied per client IP address
+}
+
+// expiryPolicy returns the policy under which a record of either kind is safe
+// to discard: its window is the longest of the account and IP windows.
+func (p LoginProtection) expiryPolicy() model.LockoutPolicy {
+	policy := p.Account
+	if p.IP.Window > policy.Window {
+		policy = p.IP
+	}
+	return policy
+}
+
+// LoginResult is the outcome of a successful password check.
+// Token and RefreshToken are set when the login is complete; users with MFA
+// enabled instead receive an MFAToken to exchange for them with a second factor.
+type LoginResult struct {
+	Token        string
+	RefreshToken string // Obtains new access tokens for the session, see Refresh
+	MFAToken     string
+}
+
 // AuthService handles user authentication and token issuance
 type AuthService struct {
-	userService *service.UserService
-	jwtService  *JWTService
+	userService    *service.UserService
+	jwtService     *JWTService
+	mfaService     *MFAService
+	sessionService *SessionService
+	hasher         PasswordHasher
+	attemptRepo    repository.LoginAttemptRepository
+	protection     LoginProtection
+	auditService   *AuditService
+	dummyHash      string // Compared against when no user matches, so response times don't reveal accounts
 }
 
 // NewAuthService creates a new authentication service
-func NewAuthService(userService *service.UserService, jwtService *JWTService) *AuthService {
+func NewAuthService(
+	userService *service.UserService,
+	jwtService *JWTService,
+	mfaService *MFAService,
+	sessionService *SessionService,
+	hasher PasswordHasher,
+	attemptRepo repository.LoginAttemptRepository,
+	protection LoginProtection,
+	auditService *AuditService,
+) *AuthService {
+	dummyHash, err := hasher.Hash("not a real password")
+	if err != nil {
+		logger.Error(constants.UnexpectedError, err)
+	}
+
 	return &AuthService{
-		userService: userService,
-		jwtService:  jwtService,
+		userService:    userService,
+		jwtService:     jwtService,
+		mfaService:     mfaService,
+		sessionService: sessionService,
+		hasher:         hasher,
+		attemptRepo:    attemptRepo,
+		protection:     protection,
+		auditService:   auditService,
+		dummyHash:      dummyHash,
 	}
 }
 
-// Login authenticates a user and issues a JWT token
-func (s *AuthService) Login(username, password string) (string, error) {
-	// Using simple authentication for demo purposes
-	// In a real application, password comparison and database lookups would occur here
+// accountKey returns the login attempt key for a username in a tenant
+func accountKey(tenantID, username string) string {
+	return "account:" + tenantID + ":" + strings.ToLower(username)
+}
+
+// mfaKey returns the attempt key for second factor codes of a user
+func mfaKey(userID int) string {
+	return "mfa:" + strconv.Itoa(userID)
+}
+
+// ipKey returns the login attempt key for a client IP address
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+// Login authenticates a user and starts a session for the client, issuing a
+// JWT token and a refresh token, or an MFA challenge token if the user has
+// enabled multi-factor authentication.
+// The token is granted the space-separated scopes requested in scope, or every
+// scope if it is empty, so integrations can sign in with a read-only token.
+// Repeated failures for the same account or IP address are progressively
+// delayed and eventually locked out, returning an ErrTooManyAttempts error.
+func (s *AuthService) Login(ctx context.Context, username, password, scope, clientIP, userAgent string) (*LoginResult, error) {
+	now := time.Now()
+
+	tenantID, err := model.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := model.NarrowScopes(model.Scopes, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.attemptRepo.FindByKey(ctx, accountKey(tenantID, username))
+	if err != nil {
+		return nil, err
+	}
+	ip, err := s.attemptRepo.FindByKey(ctx, ipKey(clientIP))
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject attempts while the account or IP address is delayed or locked
+	if err := checkBlocked(now, account, ip); err != nil {
+		return nil, err
+	}
+
+	userID, isAdmin, ok := s.checkCredentials(ctx, tenantID, username, password)
+	if !ok {
+		s.recordFailure(ctx, account.Key(), s.protection.Account, username, clientIP, now)
+		s.recordFailure(ctx, ip.Key(), s.protection.IP, username, clientIP, now)
+		return nil, ErrInvalidCredentials
+	}
 
-	// Demo static credentials
-	if username == "admin" && password == "password" {
-		// Generate token for admin user
-		token, err := s.jwtService.GenerateToken(1, username, true)
+	// A successful login clears the account's failure history
+	if err := s.attemptRepo.Delete(ctx, account.Key()); err != nil {
+		return nil, err
+	}
+
+	// Suspended and deactivated users cannot log in
+	if err := s.CheckAccount(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	// Users with MFA must complete the login with a second factor
+	if s.mfaService.IsEnabled(ctx, userID) {
+		mfaToken, err := s.mfaService.IssueChallenge(ctx, MFAChallenge{TenantID: tenantID, UserID: userID, Username: username, IsAdmin: isAdmin, Scopes: scopes})
 		if err != nil {
-			return "", errors.New(constants.TokenCreationFailed)
+			return nil, errors.New(constants.TokenCreationFailed)
+		}
+		return &LoginResult{MFAToken: mfaToken}, nil
+	}
+
+	return s.startSession(ctx, model.SessionGrant{
+		UserID:   userID,
+		Username: username,
+		Admin:    isAdmin,
+		AMR:      []string{AMRPassword},
+		Scopes:   scopes,
+	}, clientIP, userAgent)
+}
+
+// CompleteMFA finishes a login with MFA: it exchanges the challenge token
+// returned by Login and a code from the user's authenticator, or a recovery
+// code, for an access token and a refresh token, starting a session for the
+// client. Wrong codes count towards the account's lockout.
+func (s *AuthService) CompleteMFA(ctx context.Context, mfaToken, code, clientIP, userAgent string) (*LoginResult, error) {
+	challenge, err := s.mfaService.ParseChallenge(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMFACode(ctx, challenge.UserID, challenge.Username, clientIP, func() error {
+		return s.mfaService.VerifyCode(ctx, challenge.UserID, code, clientIP)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The account may have been disabled since the first step
+	if err := s.CheckAccount(ctx, challenge.UserID); err != nil {
+		return nil, err
+	}
+
+	return s.startSession(ctx, model.SessionGrant{
+		UserID:   challenge.UserID,
+		Username: challenge.Username,
+		Admin:    challenge.IsAdmin,
+		AMR:      []string{AMRPassword, AMROTP, AMRMFA},
+		Scopes:   challenge.Scopes,
+	}, clientIP, userAgent)
+}
+
+// ConfirmMFA enables MFA for a user with a code from their newly enrolled
+// authenticator, see MFAService.Confirm. Wrong codes count towards the same
+// lockout as those of CompleteMFA.
+func (s *AuthService) ConfirmMFA(ctx context.Context, userID int, username, code, clientIP string) (*dto.MFARecoveryCodesResponse, error) {
+	var codes *dto.MFARecoveryCodesResponse
+	err := s.checkMFACode(ctx, userID, username, clientIP, func() error {
+		var err error
+		codes, err = s.mfaService.Confirm(ctx, userID, code, clientIP)
+		return err
+	})
+	return codes, err
+}
+
+// DisableMFA turns MFA off for a user after checking a current authenticator
+// or recovery code, see MFAService.Disable. Wrong codes count towards the same
+// lockout as those of CompleteMFA.
+func (s *AuthService) DisableMFA(ctx context.Context, userID int, username, code, clientIP string) error {
+	return s.checkMFACode(ctx, userID, username, clientIP, func() error {
+		return s.mfaService.Disable(ctx, userID, code, clientIP)
+	})
+}
+
+// checkMFACode runs verify unless the second factor of the user is locked.
+// An ErrInvalidMFACode counts towards the lockout, a success clears it.
+func (s *AuthService) checkMFACode(ctx context.Context, userID int, username, clientIP string, verify func() error) error {
+	now := time.Now()
+
+	attempts, err := s.attemptRepo.FindByKey(ctx, mfaKey(userID))
+	if err != nil {
+		return err
+	}
+	if err := checkBlocked(now, attempts); err != nil {
+		return err
+	}
+
+	if err := verify(); err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			s.recordFailure(ctx, attempts.Key(), s.protection.Account, username, clientIP, now)
+		}
+		return err
+	}
+
+	return s.attemptRepo.Delete(ctx, attempts.Key())
+}
+
+// Refresh exchanges the refresh token of a session for a new access token and
+// refresh token. The session must still be active and the user allowed to sign
+// in; sessions started before the user's last password change are rejected.
+func (s *AuthService) Refresh(ctx context.Context, refreshToken, clientIP, userAgent string) (*LoginResult, error) {
+	session, newRefreshToken, err := s.sessionService.Rotate(ctx, refreshToken, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkUser(ctx, session.UserID(), session.CreatedAt()); err != nil {
+		return nil, err
+	}
+
+	token, err := s.jwtService.GenerateToken(ctx, session)
+	if err != nil {
+		return nil, errors.New(constants.TokenCreationFailed)
+	}
+	return &LoginResult{Token: token, RefreshToken: newRefreshToken}, nil
+}
+
+// startSession starts a session for a completed login and issues its tokens
+func (s *AuthService) startSession(ctx context.Context, grant model.SessionGrant, clientIP, userAgent string) (*LoginResult, error) {
+	session, refreshToken, err := s.sessionService.Create(ctx, grant, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.jwtService.GenerateToken(ctx, session)
+	if err != nil {
+		return nil, errors.New(constants.TokenCreationFailed)
+	}
+	return &LoginResult{Token: token, RefreshToken: refreshToken}, nil
+}
+
+// checkBlocked returns an ErrTooManyAttempts error if any of the attempts is delayed or locked
+func checkBlocked(now time.Time, attempts ...*model.LoginAttempts) error {
+	for _, a := range attempts {
+		if until, blocked := a.BlockedUntil(now); blocked {
+			return &appErrors.ErrTooManyAttempts{
+				RetryAfter: until.Sub(now).Truncate(time.Second) + time.Second,
+				Locked:     a.IsLocked(now),
+			}
+		}
+	}
+	return nil
+}
+
+// CheckToken verifies that a user's access token may still be used: the user's
+// lifecycle state must allow signing in, the token must not predate the user's
+// last password change, which revokes every token issued before it, and the
+// session it belongs to, if any, must not have been revoked.
+func (s *AuthService) CheckToken(ctx context.Context, claims *Claims) error {
+	if err := s.checkUser(ctx, claims.UserID, claims.IssuedAt.Time); err != nil {
+		return err
+	}
+	if claims.SessionID != 0 {
+		return s.sessionService.Check(ctx, claims.SessionID)
+	}
+	return nil
+}
+
+// checkUser verifies that a user may still use credentials issued at the given time.
+func (s *AuthService) checkUser(ctx context.Context, userID int, issuedAt time.Time) error {
+	user, err := s.activeUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if issuedAt.Unix() < user.PasswordChangedAt().Unix() {
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+// CheckAccount verifies that a user's lifecycle state allows signing in.
+// It returns ErrAccountDisabled for suspended, deactivated and deleted users.
+func (s *AuthService) CheckAccount(ctx context.Context, userID int) error {
+	_, err := s.activeUser(ctx, userID)
+	return err
+}
+
+// activeUser returns a user whose lifecycle state allows signing in
+func (s *AuthService) activeUser(ctx context.Context, userID int) (*model.User, error) {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		var notFound *appErrors.ErrNotFound
+		if errors.As(err, &notFound) {
+			return nil, ErrAccountDisabled
 		}
-		return token, nil
+		return nil, err
 	}
 
-	// In a real scenario, we would look up the user by username and validate the password
-	// Example:
-	// user, err := s.userService.FindByUsername(username)
-	// if err != nil {
-	//     return "", errors.New(constants.UserNotFound)
-	// }
-	// if !passwordMatches(password, user.Password()) {
-	//     return "", errors.New(constants.InvalidCredentials)
-	// }
-	// token, err := s.jwtService.GenerateToken(user.ID(), user.Username(), user.IsAdmin())
+	if !user.Status().CanLogIn() {
+		return nil, ErrAccountDisabled
+	}
+	return user, nil
+}
+
+// Demo admin credentials, signing in to the sample administrator account with the demo admin email address
+const (
+	demoAdminUsername = "admin"
+	demoAdminEmail    = "admin@example.com"
+	demoAdminPassword = "password"
+)
+
+// checkCredentials verifies a username and password pair and returns the
+// authenticated user's ID and whether they are an administrator.
+// Users sign in with their email address and password. The demo admin account
+// of the default tenant also signs in as "admin", and keeps the static demo
+// password until it sets a password of its own.
+func (s *AuthService) checkCredentials(ctx context.Context, tenantID, username, password string) (int, bool, bool) {
+	defaultTenant := tenantID == model.DefaultTenantID
+	if username == demoAdminUsername && defaultTenant {
+		username = demoAdminEmail
+	}
+
+	user, err := s.userService.GetUserByEmail(ctx, username)
+	if err == nil {
+		isAdmin := defaultTenant && user.Email().String() == demoAdminEmail
+		switch {
+		case user.HasPassword():
+			return user.ID(), isAdmin, s.hasher.Matches(user.PasswordHash(), password)
+		case isAdmin:
+			return user.ID(), true, password == demoAdminPassword
+		}
+	}
+
+	// Spend the same time as a real comparison so unknown accounts can't be detected
+	s.hasher.Matches(s.dummyHash, password)
+	return 0, false, false
+}
+
+// recordFailure registers a failed attempt and audits the lockout it may trigger
+func (s *AuthService) recordFailure(ctx context.Context, key string, policy model.LockoutPolicy, username, clientIP string, now time.Time) {
+	attempts, locked, err := s.attemptRepo.RecordFailure(ctx, key, now, policy)
+	if err != nil {
+		logger.Error(constants.LoginAttemptSaveFailed, key, err)
+		return
+	}
+
+	if locked {
+		logger.Warn(constants.LoginLockoutLog, key, attempts.LockedUntil().Format(time.RFC3339))
+		s.auditService.Record(ctx, AuditLoginLockout, username, key, clientIP, map[string]string{
+			"locked_until": attempts.LockedUntil().Format(time.RFC3339),
+		})
+	}
+}
+
+// RunAttemptCleanup periodically removes failed attempt records that no longer
+// affect logins until ctx is canceled. It is meant to be run as a background worker.
+func (s *AuthService) RunAttemptCleanup(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.attemptRepo.DeleteExpired(ctx, time.Now(), s.protection.expiryPolicy()); err != nil && ctx.Err() == nil {
+				logger.Error(constants.LoginAttemptCleanupFailed, err)
+			}
+		}
+	}
+}
+
+// Unlock clears the failed attempts and lockout of an account of the tenant
+// of ctx and/or a client IP address.
+// The admin performing the unlock is recorded in the audit log.
+func (s *AuthService) Unlock(ctx context.Context, admin, username, clientIP, requestIP string) error {
+	if username == "" && clientIP == "" {
+		return &appErrors.ErrInvalidRequest{Field: "unlock target", Message: "username or ip is required"}
+	}
+
+	tenantID, err := model.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	if username != "" {
+		keys = append(keys, accountKey(tenantID, username))
+	}
+	if clientIP != "" {
+		keys = append(keys, ipKey(clientIP))
+	}
+
+	for _, key := range keys {
+		if err := s.attemptRepo.Delete(ctx, key); err != nil {
+			return fmt.Errorf("%w: %w", service.ErrRepositoryError, err)
+		}
+		s.auditService.Record(ctx, AuditLoginUnlock, admin, key, requestIP, nil)
+	}
 
-	return "", errors.New(constants.InvalidCredentials)
+	return nil
 }
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+func TestDemoAdminLogin(t *testing.T) {
+	env := newTestEnv(t)
+
+	login, err := env.auth.Login(testContext(), "admin", "password", "", "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("Login() unexpected error = %v", err)
+	}
+	claims, err := env.jwt.ValidateToken(testContext(), login.Token)
+	if err != nil {
+		t.Fatalf("ValidateToken() unexpected error = %v", err)
+	}
+	if claims.UserID != adminID || !claims.Admin {
+		t.Errorf("token of user %d with admin %v, want admin account %d", claims.UserID, claims.Admin, adminID)
+	}
+
+	// Suspending a sample user leaves the admin signed in
+	if _, err := env.users.TransitionUser(testContext(), johnID, model.UserTransitionSuspend, "admin", "testing"); err != nil {
+		t.Fatalf("TransitionUser() unexpected error = %v", err)
+	}
+	if err := env.auth.CheckToken(testContext(), claims); err != nil {
+		t.Errorf("CheckToken() after suspending another user error = %v", err)
+	}
+
+	// Suspending the admin account locks the admin out like any other user
+	if _, err := env.users.TransitionUser(testContext(), adminID, model.UserTransitionSuspend, "admin", "testing"); err != nil {
+		t.Fatalf("TransitionUser() unexpected error = %v", err)
+	}
+	if err := env.auth.CheckToken(testContext(), claims); !errors.Is(err, ErrAccountDisabled) {
+		t.Errorf("CheckToken() error = %v, want %v", err, ErrAccountDisabled)
+	}
+	if _, err := env.auth.Login(testContext(), "admin", "password", "", "127.0.0.1", "test"); !errors.Is(err, ErrAccountDisabled) {
+		t.Errorf("Login() error = %v, want %v", err, ErrAccountDisabled)
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/event"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/i18n"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ErrInvalidVerificationToken is returned when a verification token is malformed,
+// expired, superseded by a newer one or already used
+var ErrInvalidVerificationToken = errors.New("invalid verification token")
+
+// Register how the email verification errors are reported to API clients
+func init() {
+	appErrors.Register(ErrInvalidVerificationToken, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidVerification,
+		Title:  constants.InvalidVerificationToken,
+		Detail: constants.InvalidVerificationDetail,
+	})
+}
+
+// emailVerificationPurpose distinguishes verification tokens from access tokens
+// signed with the same key, so neither can be used in place of the other
+const emailVerificationPurpose = "email_verification"
+
+// EmailVerificationPolicy configures email verification links.
+type EmailVerificationPolicy struct {
+	URL            string        // Page receiving the token in its "token" query parameter
+	TTL            time.Duration // How long a link stays valid
+	ResendInterval time.Duration // Minimum interval between links sent to a user
+}
+
+// verificationClaims are the claims of an email verification token
+type verificationClaims struct {
+	TenantID string `json:"tid"`
+	Email    string `json:"email"`
+	Purpose  string `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// EmailVerificationService sends email verification links and confirms them.
+// Links carry a signed, expiring token; the token ID is stored so that a link
+// can only be used once and only the most recent link of a user works.
+type EmailVerificationService struct {
+	userService      *domainService.UserService
+	verificationRepo repository.EmailVerificationRepository
+	secrets          SecretProvider
+	mailer           Mailer
+	policy           EmailVerificationPolicy
+}
+
+// NewEmailVerificationService creates a new email verification service
+func NewEmailVerificationService(
+	userService *domainService.UserService,
+	verificationRepo repository.EmailVerificationRepository,
+	secrets SecretProvider,
+	mailer Mailer,
+	policy EmailVerificationPolicy,
+) *EmailVerificationService {
+	return &EmailVerificationService{
+		userService:      userService,
+		verificationRepo: verificationRepo,
+		secrets:          secrets,
+		mailer:           mailer,
+		policy:           policy,
+	}
+}
+
+// HandleEmailChanged sends a verification link when a user registers or changes their email.
+// It is subscribed to model.UserRegistered and model.UserEmailChanged events.
+func (s *EmailVerificationService) HandleEmailChanged(ctx context.Context, e event.Event) error {
+	var userID int
+	switch changed := e.(type) {
+	case model.UserRegistered:
+		userID = changed.UserID
+	case model.UserEmailChanged:
+		userID = changed.UserID
+	default:
+		return fmt.Errorf("unexpected event type %T", e)
+	}
+
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.send(ctx, user, time.Now())
+}
+
+// Resend sends a new verification link to the user with the given email address.
+// To avoid revealing which addresses have accounts, it succeeds without sending
+// anything if no unverified user has the address or a link was sent too recently.
+func (s *EmailVerificationService) Resend(ctx context.Context, email string) error {
+	user, err := s.userService.GetUserByEmail(ctx, email)
+	if errors.Is(err, domainService.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if user.EmailVerified() {
+		return nil
+	}
+
+	now := time.Now()
+	if previous, err := s.verificationRepo.FindByUserID(ctx, user.ID()); err == nil && !previous.CanResend(now, s.policy.ResendInterval) {
+		logger.Info(constants.VerificationResendSkip, user.ID(), previous.SentAt().Format(time.RFC3339))
+		return nil
+	}
+
+	return s.send(ctx, user, now)
+}
+
+// Verify confirms the email address carried by a verification token.
+// The token is consumed, so using the same link again fails. Links work
+// whichever tenant the request was made for, as the token names the user's tenant.
+func (s *EmailVerificationService) Verify(ctx context.Context, token string) (*dto.UserResponse, error) {
+	claims, err := s.parseToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	ctx = model.WithTenant(ctx, claims.TenantID)
+
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	// Only the most recent, unused link of the user is accepted
+	verification, err := s.verificationRepo.FindByUserID(ctx, userID)
+	if err != nil || verification.TokenID() != claims.ID || verification.IsExpired(time.Now()) {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	user, err := s.userService.VerifyEmail(ctx, userID, claims.Email)
+	if errors.Is(err, model.ErrEmailMismatch) {
+		return nil, ErrInvalidVerificationToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verificationRepo.Delete(ctx, userID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	response := dto.ToUserResponse(user)
+	return &response, nil
+}
+
+// send issues a new verification link to a user, replacing any previous one
+func (s *EmailVerificationService) send(ctx context.Context, user *model.User, now time.Time) error {
+	tokenID, err := randomToken(16, hex.EncodeToString)
+	if err != nil {
+		return err
+	}
+	verification := model.NewEmailVerification(user.ID(), user.Email(), tokenID, now, s.policy.TTL)
+
+	token, err := s.signToken(ctx, verification, user.TenantID())
+	if err != nil {
+		return err
+	}
+
+	link, err := url.Parse(s.policy.URL)
+	if err != nil {
+		return fmt.Errorf("invalid verification URL: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	if err := s.verificationRepo.Save(ctx, verification); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	// Write the email in the user's language
+	locale := user.Locale()
+	return s.mailer.Send(ctx, MailMessage{
+		To:      user.Email().String(),
+		Subject: i18n.Translate(locale, constants.VerificationEmailSubject),
+		Body: i18n.Translate(locale, constants.VerificationEmailBody,
+			user.DisplayName(), link.String(), verification.ExpiresAt().UTC().Format(time.RFC1123)),
+	})
+}
+
+// signToken creates the signed token of a verification link for a user of a tenant
+func (s *EmailVerificationService) signToken(ctx context.Context, verification *model.EmailVerification, tenantID string) (string, error) {
+	secretKey, err := s.secrets.GetSecret(ctx, JWTSecretName)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", constants.TokenCreationFailed, err)
+	}
+
+	claims := verificationClaims{
+		TenantID: tenantID,
+		Email:    verification.Email().String(),
+		Purpose:  emailVerificationPurpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        verification.TokenID(),
+			Subject:   strconv.Itoa(verification.UserID()),
+			IssuedAt:  jwt.NewNumericDate(verification.SentAt()),
+			ExpiresAt: jwt.NewNumericDate(verification.ExpiresAt()),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secretKey))
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", constants.TokenCreationFailed, err)
+	}
+	return token, nil
+}
+
+// parseToken verifies the signature, expiry and purpose of a verification token
+func (s *EmailVerificationService) parseToken(ctx context.Context, token string) (*verificationClaims, error) {
+	secretKey, err := s.secrets.GetSecret(ctx, JWTSecretName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.TokenInvalid, err)
+	}
+
+	claims := &verificationClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("%s: %v", constants.TokenInvalid, token.Header["alg"])
+		}
+		return []byte(secretKey), nil
+	})
+	if err != nil || claims.Purpose != emailVerificationPurpose || claims.TenantID == "" {
+		return nil, ErrInvalidVerificationToken
+	}
+	return claims, nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// newTestEmailVerification returns an email verification service for the sample users
+func newTestEmailVerification(t *testing.T, resendInterval time.Duration) (*EmailVerificationService, *domainService.UserService, *recordingMailer) {
+	t.Helper()
+
+	users, _ := testUsers(t)
+	mailer := &recordingMailer{}
+	service := NewEmailVerificationService(users, inmemory.NewInMemoryEmailVerificationRepository(), testSecrets(), mailer,
+		EmailVerificationPolicy{
+			URL:            "https://app.example.com/verify-email",
+			TTL:            time.Hour,
+			ResendInterval: resendInterval,
+		})
+	return service, users, mailer
+}
+
+func TestEmailVerificationSingleUse(t *testing.T) {
+	ctx := testContext()
+	service, _, mailer := newTestEmailVerification(t, 0)
+
+	if err := service.Resend(ctx, "bob@example.com"); err != nil {
+		t.Fatalf("Resend() unexpected error = %v", err)
+	}
+	token := mailer.lastToken(t)
+
+	user, err := service.Verify(ctx, token)
+	if err != nil {
+		t.Fatalf("Verify() unexpected error = %v", err)
+	}
+	if !user.EmailVerified {
+		t.Errorf("Verify() email_verified = false, want true")
+	}
+
+	if _, err := service.Verify(ctx, token); !errors.Is(err, ErrInvalidVerificationToken) {
+		t.Errorf("Verify() again error = %v, want %v", err, ErrInvalidVerificationToken)
+	}
+}
+
+func TestEmailVerificationSuperseded(t *testing.T) {
+	ctx := testContext()
+	service, _, mailer := newTestEmailVerification(t, 0)
+
+	if err := service.Resend(ctx, "bob@example.com"); err != nil {
+		t.Fatalf("Resend() unexpected error = %v", err)
+	}
+	superseded := mailer.lastToken(t)
+	if err := service.Resend(ctx, "bob@example.com"); err != nil {
+		t.Fatalf("Resend() unexpected error = %v", err)
+	}
+	latest := mailer.lastToken(t)
+
+	if _, err := service.Verify(ctx, superseded); !errors.Is(err, ErrInvalidVerificationToken) {
+		t.Errorf("Verify() superseded link error = %v, want %v", err, ErrInvalidVerificationToken)
+	}
+	if _, err := service.Verify(ctx, latest); err != nil {
+		t.Errorf("Verify() latest link unexpected error = %v", err)
+	}
+}
+
+func TestEmailVerificationEmailChanged(t *testing.T) {
+	ctx := testContext()
+	service, users, mailer := newTestEmailVerification(t, 0)
+
+	if err := service.Resend(ctx, "bob@example.com"); err != nil {
+		t.Fatalf("Resend() unexpected error = %v", err)
+	}
+	token := mailer.lastToken(t)
+
+	// The change is not subscribed to, so the link is not replaced and only the address differs
+	if _, err := users.UpdateUser(ctx, bobID, domainService.UserData{Name: "Bob Johnson", Email: "bobby@example.com", Age: 45}); err != nil {
+		t.Fatalf("UpdateUser() unexpected error = %v", err)
+	}
+
+	if _, err := service.Verify(ctx, token); !errors.Is(err, ErrInvalidVerificationToken) {
+		t.Errorf("Verify() after email change error = %v, want %v", err, ErrInvalidVerificationToken)
+	}
+
+	user, err := users.GetUserByID(ctx, bobID)
+	if err != nil {
+		t.Fatalf("GetUserByID() unexpected error = %v", err)
+	}
+	if user.EmailVerified() {
+		t.Errorf("new email verified with the link of the previous one")
+	}
+}
+
+func TestEmailVerificationResend(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		times    int
+		wantSent int
+	}{
+		{
+			name:     "Throttled Within Interval",
+			email:    "bob@example.com",
+			times:    3,
+			wantSent: 1,
+		},
+		{
+			name:     "Unknown Address",
+			email:    "nobody@example.com",
+			times:    1,
+			wantSent: 0,
+		},
+		{
+			name:     "Already Verified",
+			email:    "jane@example.com",
+			times:    1,
+			wantSent: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testContext()
+			service, _, mailer := newTestEmailVerification(t, time.Hour)
+
+			for i := 0; i < tt.times; i++ {
+				if err := service.Resend(ctx, tt.email); err != nil {
+					t.Fatalf("Resend() unexpected error = %v", err)
+				}
+			}
+			if got := mailer.sent(); got != tt.wantSent {
+				t.Errorf("Resend() sent %d messages, want %d", got, tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestValidateTokenRejectsVerificationToken(t *testing.T) {
+	ctx := testContext()
+	service, _, mailer := newTestEmailVerification(t, 0)
+
+	if err := service.Resend(ctx, "bob@example.com"); err != nil {
+		t.Fatalf("Resend() unexpected error = %v", err)
+	}
+
+	// A purpose token that otherwise passes every access token check
+	withPurpose, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{
+		TenantID: model.DefaultTenantID,
+		Purpose:  emailVerificationPurpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    testPolicy.Issuer,
+			Audience:  jwt.ClaimStrings{testPolicy.Audience},
+			Subject:   "3",
+			ID:        "verification",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString([]byte(testSigningKey))
+	if err != nil {
+		t.Fatalf("SignedString() unexpected error = %v", err)
+	}
+
+	jwtService := NewJWTService(testSecrets(), inmemory.NewInMemoryRevokedTokenRepository(), testPolicy)
+	for name, token := range map[string]string{"link": mailer.lastToken(t), "purpose claim": withPurpose} {
+		if _, err := jwtService.ValidateToken(ctx, token); err == nil {
+			t.Errorf("ValidateToken(%s) error = nil, want verification token rejected as access token", name)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/eventbus"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/hashing"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/secret"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/totp"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Sample users, see inmemory.GetSampleUsers
+const (
+	johnID  = 1 // Active and verified
+	janeID  = 2 // Active and verified
+	bobID   = 3 // Pending, email not verified
+	adminID = 5 // Active and verified, the account of the demo admin credentials
+)
+
+// testPolicy is the JWT policy of the services under test
+var testPolicy = JWTPolicy{Issuer: "test-issuer", Audience: "test-audience", TTL: time.Hour}
+
+// testContext returns a context scoped to the default tenant
+func testContext() context.Context {
+	return model.WithTenant(context.Background(), model.DefaultTenantID)
+}
+
+// adminContext returns a context scoped to the default tenant for the demo admin granted every scope
+func adminContext() context.Context {
+	return WithPrincipal(testContext(), &Principal{UserID: adminID, Username: "admin", Admin: true, Scopes: model.Scopes})
+}
+
+// testSigningKey signs the tokens issued in tests
+const testSigningKey = "test-signing-key-of-at-least-32-bytes"
+
+// testSecrets returns a secret provider holding the JWT signing key
+func testSecrets() SecretProvider {
+	return secret.NewMemoryProvider(map[string]string{JWTSecretName: testSigningKey})
+}
+
+// testUsers returns a user domain service holding the sample users,
+// publishing their events through the returned bus
+func testUsers(t *testing.T) (*domainService.UserService, *eventbus.Bus) {
+	t.Helper()
+
+	userRepo := inmemory.NewInMemoryUserRepository()
+	if err := inmemory.InitializeWithSampleData(userRepo); err != nil {
+		t.Fatalf("InitializeWithSampleData() unexpected error = %v", err)
+	}
+
+	bus := eventbus.NewBus()
+	return domainService.NewUserService(userRepo, bus), bus
+}
+
+// testEnv wires the application services over in-memory repositories holding the sample users
+type testEnv struct {
+	users    *domainService.UserService
+	bus      *eventbus.Bus
+	mailer   *recordingMailer
+	audit    *AuditService
+	hasher   PasswordHasher
+	jwt      *JWTService
+	mfa      *MFAService
+	sessions *SessionService
+	auth     *AuthService
+}
+
+// newTestEnv returns the services of a fresh application
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	env := &testEnv{
+		mailer: &recordingMailer{},
+		audit:  NewAuditService(inmemory.NewInMemoryAuditRepository()),
+		hasher: hashing.NewBcryptHasher(bcrypt.MinCost),
+		jwt:    NewJWTService(testSecrets(), inmemory.NewInMemoryRevokedTokenRepository(), testPolicy),
+	}
+	env.users, env.bus = testUsers(t)
+	env.mfa = NewMFAService(env.users, inmemory.NewInMemoryMFACredentialRepository(), totp.NewGenerator(1), testSecrets(), env.audit,
+		MFAPolicy{Issuer: "Test", ChallengeTTL: time.Minute})
+	env.sessions = NewSessionService(inmemory.NewInMemorySessionRepository(), env.users, env.audit, time.Hour)
+
+	lockout := model.LockoutPolicy{MaxFailures: 3, Window: time.Minute, LockoutDuration: time.Minute}
+	env.auth = NewAuthService(env.users, env.jwt, env.mfa, env.sessions, env.hasher, inmemory.NewInMemoryLoginAttemptRepository(),
+		LoginProtection{Account: lockout, IP: lockout}, env.audit)
+	return env
+}
+
+// setPassword gives a sample user a password to sign in with
+func (env *testEnv) setPassword(t *testing.T, userID int, password string) {
+	t.Helper()
+
+	hash, err := env.hasher.Hash(password)
+	if err != nil {
+		t.Fatalf("Hash() unexpected error = %v", err)
+	}
+	if _, err := env.users.ChangePassword(testContext(), userID, hash); err != nil {
+		t.Fatalf("ChangePassword() unexpected error = %v", err)
+	}
+}
+
+// recordingMailer keeps the messages sent through it, failing with err if set
+type recordingMailer struct {
+	messages []MailMessage
+	err      error
+	mu       sync.Mutex
+}
+
+func (m *recordingMailer) Send(_ context.Context, message MailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+// sent returns the number of messages sent
+func (m *recordingMailer) sent() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.messages)
+}
+
+// linkToken matches the token parameter of a link in a message body
+var linkToken = regexp.MustCompile(`token=([^\s&]+)`)
+
+// lastToken returns the token of the link in the last message sent
+func (m *recordingMailer) lastToken(t *testing.T) string {
+	t.Helper()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.messages) == 0 {
+		t.Fatalf("no message was sent")
+	}
+	match := linkToken.FindStringSubmatch(m.messages[len(m.messages)-1].Body)
+	if match == nil {
+		t.Fatalf("last message has no link token")
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatalf("QueryUnescape() unexpected error = %v", err)
+	}
+	return token
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Impersonation errors
+var (
+	ErrImpersonationNotAllowed = errors.New("user cannot be impersonated")                      // The target of an impersonation is an administrator or the caller
+	ErrImpersonationRestricted = errors.New("action is not allowed while impersonating a user") // Only the user themselves may perform the action
+)
+
+// Register how the impersonation errors are reported to API clients
+func init() {
+	appErrors.Register(ErrImpersonationNotAllowed, appErrors.Mapping{
+		Status: http.StatusForbidden,
+		Code:   appErrors.CodeImpersonationNotAllowed,
+		Title:  constants.ImpersonationNotAllowed,
+		Detail: constants.ImpersonationNotAllowedDetail,
+	})
+	appErrors.Register(ErrImpersonationRestricted, appErrors.Mapping{
+		Status: http.StatusForbidden,
+		Code:   appErrors.CodeImpersonationRestricted,
+		Title:  constants.ImpersonationRestricted,
+		Detail: constants.ImpersonationRestrictedDetail,
+	})
+}
+
+// Audit actions of the impersonation service
+const (
+	AuditImpersonationStarted = "impersonation.started"
+	AuditImpersonationRequest = "impersonation.request"
+)
+
+// Impersonation is a token letting an administrator act as a user
+type Impersonation struct {
+	UserID    int
+	Token     string
+	ExpiresAt time.Time
+}
+
+// ImpersonationService lets administrators act as a user, for support staff
+// reproducing issues. Impersonation tokens are short-lived, name the
+// administrator in an "act" claim and every request made with them is audited.
+type ImpersonationService struct {
+	userService  *service.UserService
+	authService  *AuthService
+	jwtService   *JWTService
+	auditService *AuditService
+	ttl          time.Duration
+}
+
+// NewImpersonationService creates a new impersonation service issuing tokens valid for ttl
+func NewImpersonationService(
+	userService *service.UserService,
+	authService *AuthService,
+	jwtService *JWTService,
+	auditService *AuditService,
+	ttl time.Duration,
+) *ImpersonationService {
+	return &ImpersonationService{
+		userService:  userService,
+		authService:  authService,
+		jwtService:   jwtService,
+		auditService: auditService,
+		ttl:          ttl,
+	}
+}
+
+// Impersonate issues a token letting admin act as the user with the given ID,
+// granted the scopes of admin's token. Administrators cannot be impersonated,
+// nor can users who may not sign in.
+func (s *ImpersonationService) Impersonate(ctx context.Context, admin *Principal, userID int, clientIP string) (*Impersonation, error) {
+	if !admin.Admin || admin.IsImpersonated() {
+		return nil, ErrImpersonationNotAllowed
+	}
+	if userID == admin.UserID {
+		return nil, ErrImpersonationNotAllowed
+	}
+
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckAccount(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	// The token is limited to the scopes of the admin's own token
+	var scopes []string
+	for _, scope := range model.Scopes {
+		if admin.HasScope(scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	actor := Actor{Subject: strconv.Itoa(admin.UserID), Username: admin.Username}
+	expiresAt := time.Now().Add(s.ttl)
+	token, err := s.jwtService.GenerateImpersonationToken(ctx, user.ID(), user.Email().String(), scopes, actor, s.ttl)
+	if err != nil {
+		return nil, errors.New(constants.TokenCreationFailed)
+	}
+
+	s.auditService.Record(ctx, AuditImpersonationStarted, admin.Username, userSubject(user.ID()), clientIP, map[string]string{
+		"expires_at": expiresAt.UTC().Format(time.RFC3339),
+	})
+
+	return &Impersonation{UserID: user.ID(), Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// RecordRequest audits a request made with an impersonation token
+func (s *ImpersonationService) RecordRequest(ctx context.Context, principal *Principal, method, path string, status int, clientIP string) {
+	s.auditService.Record(ctx, AuditImpersonationRequest, principal.Actor.Username, userSubject(principal.UserID), clientIP, map[string]string{
+		"method":   method,
+		"path":     path,
+		"status":   strconv.Itoa(status),
+		"token_id": principal.TokenID,
+	})
+}
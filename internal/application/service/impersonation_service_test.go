@@ -0,0 +1,39 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+func TestImpersonationScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []string
+	}{
+		{"Every Scope", model.Scopes},
+		{"Read Only", []string{model.ScopeUsersRead}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			impersonations := NewImpersonationService(env.users, env.auth, env.jwt, env.audit, time.Minute)
+			admin := &Principal{UserID: adminID, Username: "admin", Admin: true, Scopes: tt.scopes}
+
+			impersonation, err := impersonations.Impersonate(testContext(), admin, janeID, "127.0.0.1")
+			if err != nil {
+				t.Fatalf("Impersonate() unexpected error = %v", err)
+			}
+			claims, err := env.jwt.ValidateToken(testContext(), impersonation.Token)
+			if err != nil {
+				t.Fatalf("ValidateToken() unexpected error = %v", err)
+			}
+			if !slices.Equal(claims.Scopes(), tt.scopes) {
+				t.Errorf("token scopes = %v, want %v", claims.Scopes(), tt.scopes)
+			}
+		})
+	}
+}
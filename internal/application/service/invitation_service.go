@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/i18n"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrInvalidInvitation is returned when answering an invitation that is unknown,
+// expired, already answered or addressed to another email than the caller's
+var ErrInvalidInvitation = errors.New("invalid invitation")
+
+// Register how the invitation errors are reported to API clients
+func init() {
+	appErrors.Register(ErrInvalidInvitation, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidInvitation,
+		Title:  constants.InvalidInvitation,
+		Detail: constants.InvalidInvitationDetail,
+	})
+}
+
+// Audit actions of the invitation service
+const (
+	AuditInvitationSent     = "invitation.sent"
+	AuditInvitationCanceled = "invitation.canceled"
+	AuditInvitationAccepted = "invitation.accepted"
+	AuditInvitationDeclined = "invitation.declined"
+)
+
+// InvitationPolicy configures invitation links.
+type InvitationPolicy struct {
+	URL string        // Page receiving the token in its "token" query parameter
+	TTL time.Duration // How long a link stays valid
+}
+
+// InvitationService invites people to teams by email. Owners and admins of a
+// team invite an email address; the invitee signs in with an account having
+// that address and accepts or declines with the token from the email, joining
+// the team and, if they are not yet a member, its organization. Tokens are
+// random and only their SHA-256 hash is stored.
+type InvitationService struct {
+	organizations  *OrganizationService
+	invitationRepo repository.InvitationRepository
+	userService    *domainService.UserService
+	mailer         Mailer
+	auditService   *AuditService
+	policy         InvitationPolicy
+
+	// mu serializes invitation changes, so that only the latest invitation of
+	// an address works and an invitation is answered only once
+	mu sync.Mutex
+}
+
+// NewInvitationService creates a new invitation service, authorizing
+// invitations with the memberships managed by organizations
+func NewInvitationService(
+	organizations *OrganizationService,
+	invitationRepo repository.InvitationRepository,
+	userService *domainService.UserService,
+	mailer Mailer,
+	auditService *AuditService,
+	policy InvitationPolicy,
+) *InvitationService {
+	return &InvitationService{
+		organizations:  organizations,
+		invitationRepo: invitationRepo,
+		userService:    userService,
+		mailer:         mailer,
+		auditService:   auditService,
+		policy:         policy,
+	}
+}
+
+// List returns the pending invitations to a team, including expired ones.
+// Only owners and admins of the team may list them.
+func (s *InvitationService) List(ctx context.Context, userID, organizationID, teamID int) ([]dto.InvitationResponse, error) {
+	_, team, role, err := s.organizations.TeamForMember(ctx, userID, organizationID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !role.AtLeast(model.RoleAdmin) {
+		return nil, ErrRoleRequired
+	}
+
+	invitations, err := s.invitationRepo.FindByTeamID(ctx, team.ID())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	now := time.Now()
+	responses := make([]dto.InvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		if invitation.Status() == model.InvitationPending {
+			responses = append(responses, dto.ToInvitationResponse(invitation, now))
+		}
+	}
+	return responses, nil
+}
+
+// Create invites an email address to a team and sends the invitation link,
+// written in the language of the request. Owners and admins of the team may
+// invite, though only owners may invite owners. It replaces any pending
+// invitation of the address to the team, and fails with model.ErrAlreadyMember
+// if a user with the address is already in the team.
+func (s *InvitationService) Create(ctx context.Context, userID, organizationID, teamID int, request dto.InvitationRequest, actor, clientIP string) (*dto.InvitationResponse, error) {
+	role := model.RoleMember
+	if request.Role != "" {
+		parsed, err := model.ParseMemberRole(request.Role)
+		if err != nil {
+			return nil, err
+		}
+		role = parsed
+	}
+	email, err := model.NewEmail(request.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	organization, team, callerRole, err := s.organizations.TeamForMember(ctx, userID, organizationID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !callerRole.AtLeast(model.RoleAdmin) || !callerRole.AtLeast(role) {
+		return nil, ErrRoleRequired
+	}
+
+	invitee, err := s.userService.GetUserByEmail(ctx, email.String())
+	if err != nil && !errors.Is(err, domainService.ErrUserNotFound) {
+		return nil, err
+	}
+	if invitee != nil {
+		if _, ok := team.Member(invitee.ID()); ok {
+			return nil, model.ErrAlreadyMember
+		}
+	}
+
+	inviter, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := randomToken(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invitation, err := model.NewInvitation(organization.ID(), team.ID(), email, role, hashToken(token), userID, now, s.policy.TTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.replacePending(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	link, err := url.Parse(s.policy.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invitation URL: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	s.auditService.Record(ctx, AuditInvitationSent, actor, invitationSubject(invitation.ID()), clientIP, map[string]string{
+		"team":  strconv.Itoa(team.ID()),
+		"email": email.String(),
+		"role":  string(role),
+	})
+
+	// The invitee may have no account yet, so write the email in the inviter's language
+	locale := i18n.LocaleFromContext(ctx)
+	err = s.mailer.Send(ctx, MailMessage{
+		To:      email.String(),
+		Subject: i18n.Translate(locale, constants.InvitationEmailSubject, organization.Name()),
+		Body: i18n.Translate(locale, constants.InvitationEmailBody,
+			inviter.DisplayName(), team.Name(), organization.Name(), role, link.String(),
+			invitation.ExpiresAt().UTC().Format(time.RFC1123)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToInvitationResponse(invitation, now)
+	return &response, nil
+}
+
+// Cancel deletes a pending invitation to a team, so its link stops working.
+// Owners and admins of the team may cancel invitations.
+func (s *InvitationService) Cancel(ctx context.Context, userID, organizationID, teamID, invitationID int, actor, clientIP string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, team, role, err := s.organizations.TeamForMember(ctx, userID, organizationID, teamID)
+	if err != nil {
+		return err
+	}
+	if !role.AtLeast(model.RoleAdmin) {
+		return ErrRoleRequired
+	}
+
+	invitation, err := s.invitationRepo.FindByID(ctx, invitationID)
+	if err != nil || invitation.TeamID() != team.ID() || invitation.Status() != model.InvitationPending {
+		return &appErrors.ErrNotFound{Resource: "invitation", ID: invitationID}
+	}
+	if err := s.invitationRepo.Delete(ctx, invitation.ID()); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditInvitationCanceled, actor, invitationSubject(invitation.ID()), clientIP, map[string]string{
+		"team":  strconv.Itoa(team.ID()),
+		"email": invitation.Email().String(),
+	})
+	return nil
+}
+
+// Accept adds the user to the team of the invitation with the given token, in
+// the role they were invited as. Users who are not yet members of the
+// organization join it as members.
+func (s *InvitationService) Accept(ctx context.Context, userID int, token, actor, clientIP string) (*dto.TeamResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	invitation, err := s.open(ctx, userID, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := invitation.Accept(time.Now()); err != nil {
+		return nil, ErrInvalidInvitation
+	}
+
+	response, err := s.organizations.JoinTeam(ctx, userID, invitation.OrganizationID(), invitation.TeamID(), invitation.Role())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.invitationRepo.Save(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditInvitationAccepted, actor, invitationSubject(invitation.ID()), clientIP, map[string]string{
+		"team": strconv.Itoa(invitation.TeamID()),
+		"role": string(invitation.Role()),
+	})
+	return response, nil
+}
+
+// Decline turns down the invitation with the given token.
+func (s *InvitationService) Decline(ctx context.Context, userID int, token, actor, clientIP string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	invitation, err := s.open(ctx, userID, token)
+	if err != nil {
+		return err
+	}
+
+	if err := invitation.Decline(time.Now()); err != nil {
+		return ErrInvalidInvitation
+	}
+	if err := s.invitationRepo.Save(ctx, invitation); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditInvitationDeclined, actor, invitationSubject(invitation.ID()), clientIP, map[string]string{
+		"team": strconv.Itoa(invitation.TeamID()),
+	})
+	return nil
+}
+
+// open returns the open invitation with the given token, checking that it was
+// sent to the user's verified email address. It returns ErrInvalidInvitation
+// for unknown, expired and answered invitations, for invitations to another or
+// an unverified address and for teams of another tenant.
+func (s *InvitationService) open(ctx context.Context, userID int, token string) (*model.Invitation, error) {
+	invitation, err := s.invitationRepo.FindByTokenHash(ctx, hashToken(token))
+	if err != nil || !invitation.IsOpen(time.Now()) {
+		return nil, ErrInvalidInvitation
+	}
+
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.EmailVerified() || !user.Email().Equals(invitation.Email()) {
+		return nil, ErrInvalidInvitation
+	}
+
+	if _, _, err := s.organizations.FindTeam(ctx, invitation.OrganizationID(), invitation.TeamID()); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, ErrInvalidInvitation
+	}
+	return invitation, nil
+}
+
+// replacePending saves a new invitation, deleting the pending invitations of
+// its address to its team so that only the latest link works
+func (s *InvitationService) replacePending(ctx context.Context, invitation *model.Invitation) error {
+	invitations, err := s.invitationRepo.FindByTeamID(ctx, invitation.TeamID())
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	for _, previous := range invitations {
+		if previous.Status() == model.InvitationPending && previous.Email().Equals(invitation.Email()) {
+			if err := s.invitationRepo.Delete(ctx, previous.ID()); err != nil {
+				return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+			}
+		}
+	}
+
+	if err := s.invitationRepo.Save(ctx, invitation); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	return nil
+}
+
+// invitationSubject returns the audit subject identifying an invitation
+func invitationSubject(id int) string {
+	return "invitation:" + strconv.Itoa(id)
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+func TestAcceptInvitation(t *testing.T) {
+	env := newTestOrganizations(t)
+	organizationID, teamID := env.setUp(t, janeID)
+	token := env.invite(t, janeID, organizationID, teamID, "bob@example.com", model.RoleMember)
+
+	// Bob's address is not verified yet, and John is not the invitee
+	if _, err := env.invitations.Accept(testContext(), bobID, token, "bob", "127.0.0.1"); !errors.Is(err, ErrInvalidInvitation) {
+		t.Errorf("Accept() unverified address error = %v, want %v", err, ErrInvalidInvitation)
+	}
+	if _, err := env.invitations.Accept(testContext(), johnID, token, "john", "127.0.0.1"); !errors.Is(err, ErrInvalidInvitation) {
+		t.Errorf("Accept() another address error = %v, want %v", err, ErrInvalidInvitation)
+	}
+
+	if _, err := env.users.VerifyEmail(testContext(), bobID, "bob@example.com"); err != nil {
+		t.Fatalf("VerifyEmail() unexpected error = %v", err)
+	}
+	team, err := env.invitations.Accept(testContext(), bobID, token, "bob", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("Accept() unexpected error = %v", err)
+	}
+	if team.Role != string(model.RoleMember) || team.MemberCount != 2 {
+		t.Errorf("Accept() team role = %q with %d members, want member of 2", team.Role, team.MemberCount)
+	}
+
+	if _, err := env.invitations.Accept(testContext(), bobID, token, "bob", "127.0.0.1"); !errors.Is(err, ErrInvalidInvitation) {
+		t.Errorf("Accept() again error = %v, want %v", err, ErrInvalidInvitation)
+	}
+	organization, err := env.organizations.Get(testContext(), bobID, organizationID)
+	if err != nil {
+		t.Fatalf("Get() unexpected error = %v", err)
+	}
+	if organization.Role != string(model.RoleMember) {
+		t.Errorf("organization role = %q, want %q", organization.Role, model.RoleMember)
+	}
+}
@@ -1,39 +1,174 @@
 package service
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 )
 
-// JWTService handles token generation and validation
+// JWTService handles token generation and validation.
+// The signing key is fetched from the secret provider on each use, so a rotated
+// key takes effect immediately. Tokens signed with the previous key become invalid.
+// Every token carries a "jti" claim, so it can be revoked individually before it expires.
 type JWTService struct {
-	secretKey     string
-	expirationHrs int
+	secrets       SecretProvider
+	revokedTokens repository.RevokedTokenRepository
+	policy        JWTPolicy
+}
+
+// JWTPolicy configures the tokens issued by JWTService
+type JWTPolicy struct {
+	Issuer   string        // "iss" claim, required on incoming tokens
+	Audience string        // "aud" claim, required on incoming tokens
+	TTL      time.Duration // Lifetime of user tokens
 }
 
 // NewJWTService creates a new JWT service instance
-func NewJWTService(secretKey string, expirationHrs int) *JWTService {
+func NewJWTService(secrets SecretProvider, revokedTokens repository.RevokedTokenRepository, policy JWTPolicy) *JWTService {
 	return &JWTService{
-		secretKey:     secretKey,
-		expirationHrs: expirationHrs,
+		secrets:       secrets,
+		revokedTokens: revokedTokens,
+		policy:        policy,
+	}
+}
+
+// Authentication methods reported in the "amr" claim of access tokens (RFC 8176)
+const (
+	AMRPassword = "pwd" // Password
+	AMROTP      = "otp" // One-time code from an authenticator app or a recovery code
+	AMRMFA      = "mfa" // Multiple factors
+)
+
+// Claims are the claims of an access token. The subject is the user ID for
+// user tokens and the client ID for tokens issued to OAuth clients.
+type Claims struct {
+	TenantID  string   `json:"tid"` // Tenant the token was issued in
+	UserID    int      `json:"user_id,omitempty"`
+	Username  string   `json:"username,omitempty"`
+	Admin     bool     `json:"admin,omitempty"`
+	AMR       []string `json:"amr,omitempty"`       // Authentication methods, see the AMR constants
+	Scope     string   `json:"scope"`               // Space-separated granted scopes
+	SessionID int      `json:"sid,omitempty"`       // Session the token belongs to, see SessionService
+	ClientID  string   `json:"client_id,omitempty"` // OAuth client the token was issued to (RFC 8693)
+	Act       *Actor   `json:"act,omitempty"`       // Administrator impersonating the user (RFC 8693)
+	Purpose   string   `json:"purpose,omitempty"`   // Set on single-purpose tokens, which are not access tokens
+	jwt.RegisteredClaims
+}
+
+// Actor identifies the party acting on behalf of the subject of a token,
+// as in the "act" claim of RFC 8693.
+type Actor struct {
+	Subject  string `json:"sub"`                // Actor's user ID
+	Username string `json:"username,omitempty"` // Actor's username
+}
+
+// Scopes returns the granted scopes
+func (c *Claims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// Principal returns the caller identified by the claims
+func (c *Claims) Principal() *Principal {
+	username := c.Username
+	if c.ClientID != "" {
+		username = c.ClientID
+	}
+	return &Principal{
+		Subject:   c.Subject,
+		TenantID:  c.TenantID,
+		UserID:    c.UserID,
+		Username:  username,
+		Admin:     c.Admin,
+		AMR:       c.AMR,
+		Scopes:    c.Scopes(),
+		ClientID:  c.ClientID,
+		TokenID:   c.ID,
+		SessionID: c.SessionID,
+		Actor:     c.Act,
+	}
+}
+
+// GenerateToken creates a new JWT token for a user signed in with session.
+// The token lists the methods the user authenticated with in the "amr" claim,
+// see the AMR constants, the granted scopes in the space-separated "scope"
+// claim, and names the session in the "sid" claim.
+func (s *JWTService) GenerateToken(ctx context.Context, session *model.Session) (string, error) {
+	grant := session.Grant()
+	claims := &Claims{
+		UserID:    grant.UserID,
+		Username:  grant.Username,
+		Admin:     grant.Admin,
+		AMR:       grant.AMR,
+		Scope:     strings.Join(grant.Scopes, " "),
+		SessionID: session.ID(),
+	}
+	return s.sign(ctx, claims, strconv.Itoa(grant.UserID), s.policy.TTL)
+}
+
+// GenerateImpersonationToken creates a new JWT token, valid for ttl, letting
+// actor act as a user. The token grants the user's scopes but no authentication
+// methods, and names the actor in its "act" claim.
+func (s *JWTService) GenerateImpersonationToken(ctx context.Context, userID int, username string, scopes []string, actor Actor, ttl time.Duration) (string, error) {
+	claims := &Claims{
+		UserID:   userID,
+		Username: username,
+		Scope:    strings.Join(scopes, " "),
+		Act:      &actor,
+	}
+	return s.sign(ctx, claims, strconv.Itoa(userID), ttl)
+}
+
+// GenerateClientToken creates a new JWT token for an OAuth client, valid for ttl.
+// The granted scopes are listed in the space-separated "scope" claim.
+func (s *JWTService) GenerateClientToken(ctx context.Context, clientID string, scopes []string, ttl time.Duration) (string, error) {
+	claims := &Claims{
+		ClientID: clientID,
+		Scope:    strings.Join(scopes, " "),
 	}
+	return s.sign(ctx, claims, clientID, ttl)
 }
 
-// GenerateToken creates a new JWT token for a user
-func (s *JWTService) GenerateToken(userID int, username string, isAdmin bool) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id":  userID,
-		"username": username,
-		"admin":    isAdmin,
-		"exp":      time.Now().Add(time.Hour * time.Duration(s.expirationHrs)).Unix(),
-		"iat":      time.Now().Unix(),
+// sign fills in the registered claims and signs a token valid for ttl,
+// issued in the tenant of ctx
+func (s *JWTService) sign(ctx context.Context, claims *Claims, subject string, ttl time.Duration) (string, error) {
+	tenantID, err := model.TenantFromContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", constants.TokenCreationFailed, err)
+	}
+	claims.TenantID = tenantID
+
+	secretKey, err := s.secrets.GetSecret(ctx, JWTSecretName)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", constants.TokenCreationFailed, err)
+	}
+
+	tokenID := make([]byte, 16)
+	if _, err := rand.Read(tokenID); err != nil {
+		return "", fmt.Errorf("%s: %w", constants.TokenCreationFailed, err)
+	}
+
+	now := time.Now()
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		Issuer:    s.policy.Issuer,
+		Audience:  jwt.ClaimStrings{s.policy.Audience},
+		Subject:   subject,
+		ID:        hex.EncodeToString(tokenID),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		NotBefore: jwt.NewNumericDate(now),
+		IssuedAt:  jwt.NewNumericDate(now),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.secretKey))
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secretKey))
 	if err != nil {
 		return "", fmt.Errorf("%s: %w", constants.TokenCreationFailed, err)
 	}
@@ -41,32 +176,66 @@ func (s *JWTService) GenerateToken(userID int, username string, isAdmin bool) (s
 	return tokenString, nil
 }
 
-// ValidateToken verifies the validity of a token and returns its claims
-func (s *JWTService) ValidateToken(tokenString string) (*jwt.Token, jwt.MapClaims, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+// TokenTenant returns the tenant named by the "tid" claim of an access token,
+// or an empty string, without verifying the token. It lets requests be routed
+// to their tenant before authentication, which must then check the tenant of
+// the verified token, see CheckTenant.
+func TokenTenant(tokenString string) string {
+	claims := &Claims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, claims); err != nil {
+		return ""
+	}
+	return claims.TenantID
+}
+
+// RevokeToken rejects the token identified by a "jti" claim from now on.
+// expiresAt is the token's expiry, after which it needn't be remembered.
+func (s *JWTService) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	return s.revokedTokens.Add(ctx, tokenID, expiresAt)
+}
+
+// ValidateToken verifies the validity of a token and returns its claims.
+// Besides the signature and validity period, the issuer and audience must
+// match the policy and the token must not have been revoked.
+func (s *JWTService) ValidateToken(ctx context.Context, tokenString string) (*Claims, error) {
+	secretKey, err := s.secrets.GetSecret(ctx, JWTSecretName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.TokenInvalid, err)
+	}
+
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		// Validate the algorithm
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("%s: %v", constants.TokenInvalid, token.Header["alg"])
 		}
-		return []byte(s.secretKey), nil
+		return []byte(secretKey), nil
 	})
 
 	if err != nil {
-		if err.Error() == "Token is expired" {
-			return nil, nil, fmt.Errorf(constants.TokenExpired)
+		var validationErr *jwt.ValidationError
+		if errors.As(err, &validationErr) && validationErr.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, fmt.Errorf(constants.TokenExpired)
 		}
-		return nil, nil, fmt.Errorf("%s: %w", constants.TokenInvalid, err)
+		return nil, fmt.Errorf("%s: %w", constants.TokenInvalid, err)
 	}
 
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok || !token.Valid {
-		return nil, nil, fmt.Errorf(constants.TokenInvalid)
+	if !token.Valid || !claims.VerifyIssuer(s.policy.Issuer, true) || !claims.VerifyAudience(s.policy.Audience, true) {
+		return nil, fmt.Errorf(constants.TokenInvalid)
 	}
 
-	return token, claims, nil
-}
+	// Tokens issued for another purpose, such as email verification, are not access tokens
+	if claims.Purpose != "" || claims.Subject == "" || claims.ID == "" || claims.TenantID == "" {
+		return nil, fmt.Errorf(constants.TokenInvalid)
+	}
+
+	revoked, err := s.revokedTokens.Contains(ctx, claims.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.TokenInvalid, err)
+	}
+	if revoked {
+		return nil, fmt.Errorf(constants.TokenRevoked)
+	}
 
-// GetSecretKey returns the secret key
-func (s *JWTService) GetSecretKey() string {
-	return s.secretKey
+	return claims, nil
 }
@@ -0,0 +1,18 @@
+package service
+
+import "context"
+
+// MailMessage is a plain-text email.
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers emails to users, e.g. verification links.
+// Implementations may send through SMTP or a provider's API; the local ones
+// write messages to the log or to files.
+type Mailer interface {
+	// Send delivers a message
+	Send(ctx context.Context, message MailMessage) error
+}
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// MFA errors
+var (
+	ErrInvalidMFACode      = errors.New("invalid MFA code")      // The authenticator or recovery code is wrong or was already used
+	ErrInvalidMFAChallenge = errors.New("invalid MFA challenge") // The challenge token of a login is invalid or expired
+)
+
+// Register how the MFA errors are reported to API clients
+func init() {
+	appErrors.Register(ErrInvalidMFACode, appErrors.Mapping{
+		Status: http.StatusUnauthorized,
+		Code:   appErrors.CodeInvalidMFACode,
+		Title:  constants.InvalidMFACode,
+		Detail: constants.InvalidMFACodeDetail,
+	})
+	appErrors.Register(ErrInvalidMFAChallenge, appErrors.Mapping{
+		Status: http.StatusUnauthorized,
+		Code:   appErrors.CodeInvalidMFAToken,
+		Title:  constants.InvalidMFAToken,
+		Detail: constants.InvalidMFATokenDetail,
+	})
+	appErrors.Register(model.ErrMFAAlreadyEnabled, appErrors.Mapping{
+		Status: http.StatusConflict,
+		Code:   appErrors.CodeMFAAlreadyEnabled,
+		Title:  constants.MFAAlreadyEnabled,
+	})
+	appErrors.Register(model.ErrMFANotEnabled, appErrors.Mapping{
+		Status: http.StatusConflict,
+		Code:   appErrors.CodeMFANotEnabled,
+		Title:  constants.MFANotEnabled,
+	})
+}
+
+// Audit actions of the MFA service
+const (
+	AuditMFAEnabled          = "mfa.enabled"
+	AuditMFADisabled         = "mfa.disabled"
+	AuditMFARecoveryCodeUsed = "mfa.recovery_code_used"
+)
+
+// mfaChallengePurpose distinguishes MFA challenge tokens from access tokens
+// signed with the same key, so neither can be used in place of the other
+const mfaChallengePurpose = "mfa_challenge"
+
+// recoveryCodeCount is the number of recovery codes issued when MFA is enabled
+const recoveryCodeCount = 10
+
+// MFAPolicy configures multi-factor authentication.
+type MFAPolicy struct {
+	Issuer       string        // Name of the service shown in authenticator apps
+	ChallengeTTL time.Duration // How long a login has to complete the second step
+}
+
+// MFAChallenge identifies a login waiting for its second factor.
+type MFAChallenge struct {
+	TenantID string // Tenant the user is signing in to
+	UserID   int
+	Username string
+	IsAdmin  bool
+	Scopes   []string // Scopes the access token will be granted
+}
+
+// mfaChallengeClaims are the claims of an MFA challenge token
+type mfaChallengeClaims struct {
+	TenantID string `json:"tid"`
+	Username string `json:"username"`
+	Admin    bool   `json:"admin"`
+	Scope    string `json:"scope"`
+	Purpose  string `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// MFAService manages TOTP authenticators and recovery codes, and the challenge
+// tokens linking the two steps of a login with MFA.
+type MFAService struct {
+	userService    *domainService.UserService
+	credentialRepo repository.MFACredentialRepository
+	totp           TOTP
+	secrets        SecretProvider
+	auditService   *AuditService
+	policy         MFAPolicy
+
+	// mu serializes credential updates, so a code is accepted only once
+	// and a confirmation or removal can't be lost to a concurrent one
+	mu sync.Mutex
+}
+
+// NewMFAService creates a new MFA service
+func NewMFAService(
+	userService *domainService.UserService,
+	credentialRepo repository.MFACredentialRepository,
+	totp TOTP,
+	secrets SecretProvider,
+	auditService *AuditService,
+	policy MFAPolicy,
+) *MFAService {
+	return &MFAService{
+		userService:    userService,
+		credentialRepo: credentialRepo,
+		totp:           totp,
+		secrets:        secrets,
+		auditService:   auditService,
+		policy:         policy,
+	}
+}
+
+// Enroll starts MFA enrollment for a user with a new authenticator secret.
+// Enrolling again before confirming replaces the pending secret.
+func (s *MFAService) Enroll(ctx context.Context, userID int) (*dto.MFAEnrollmentResponse, error) {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if credential, err := s.credentialRepo.FindByUserID(ctx, userID); err == nil && credential.IsConfirmed() {
+		return nil, model.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := s.totp.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.credentialRepo.Save(ctx, model.NewMFACredential(userID, secret, time.Now())); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	return &dto.MFAEnrollmentResponse{
+		Secret:          secret,
+		ProvisioningURI: s.totp.ProvisioningURI(secret, s.policy.Issuer, user.Email().String()),
+	}, nil
+}
+
+// Confirm enables MFA with a code from the newly enrolled authenticator and
+// returns the recovery codes, which are stored hashed and can't be shown again.
+func (s *MFAService) Confirm(ctx context.Context, userID int, code, clientIP string) (*dto.MFARecoveryCodesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credential, err := s.credentialRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, model.ErrMFANotEnabled
+	}
+	if credential.IsConfirmed() {
+		return nil, model.ErrMFAAlreadyEnabled
+	}
+
+	counter, ok := s.totp.Verify(credential.Secret(), code, time.Now())
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := credential.Confirm(counter, hashes, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.credentialRepo.Save(ctx, credential); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditMFAEnabled, s.actor(ctx, userID), userSubject(userID), clientIP, nil)
+	return &dto.MFARecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// Disable turns MFA off after checking a current authenticator or recovery code.
+func (s *MFAService) Disable(ctx context.Context, userID int, code, clientIP string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.verifyCode(ctx, userID, code, clientIP); err != nil {
+		return err
+	}
+
+	if err := s.credentialRepo.Delete(ctx, userID); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditMFADisabled, s.actor(ctx, userID), userSubject(userID), clientIP, nil)
+	return nil
+}
+
+// IsEnabled reports whether a user has confirmed an authenticator.
+func (s *MFAService) IsEnabled(ctx context.Context, userID int) bool {
+	credential, err := s.credentialRepo.FindByUserID(ctx, userID)
+	return err == nil && credential.IsConfirmed()
+}
+
+// VerifyCode checks a code from the user's authenticator or one of their recovery codes.
+// Each code is accepted only once: authenticator codes can't be replayed and
+// recovery codes are consumed.
+func (s *MFAService) VerifyCode(ctx context.Context, userID int, code, clientIP string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.verifyCode(ctx, userID, code, clientIP)
+}
+
+// verifyCode implements VerifyCode, the caller must hold s.mu
+func (s *MFAService) verifyCode(ctx context.Context, userID int, code, clientIP string) error {
+	credential, err := s.credentialRepo.FindByUserID(ctx, userID)
+	if err != nil || !credential.IsConfirmed() {
+		return model.ErrMFANotEnabled
+	}
+
+	code = strings.TrimSpace(code)
+	if counter, ok := s.totp.Verify(credential.Secret(), code, time.Now()); ok {
+		if !credential.UseCounter(counter) {
+			return ErrInvalidMFACode
+		}
+	} else if credential.UseRecoveryCode(hashRecoveryCode(code)) {
+		left := len(credential.RecoveryCodes())
+		logger.Warn(constants.RecoveryCodeUsed, userID, left)
+		s.auditService.Record(ctx, AuditMFARecoveryCodeUsed, s.actor(ctx, userID), userSubject(userID), clientIP, map[string]string{
+			"remaining": strconv.Itoa(left),
+		})
+	} else {
+		return ErrInvalidMFACode
+	}
+
+	if err := s.credentialRepo.Save(ctx, credential); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	return nil
+}
+
+// IssueChallenge creates the token a client exchanges, together with an
+// authenticator code, for an access token in the second step of a login.
+func (s *MFAService) IssueChallenge(ctx context.Context, challenge MFAChallenge) (string, error) {
+	secretKey, err := s.secrets.GetSecret(ctx, JWTSecretName)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", constants.TokenCreationFailed, err)
+	}
+
+	now := time.Now()
+	claims := mfaChallengeClaims{
+		TenantID: challenge.TenantID,
+		Username: challenge.Username,
+		Admin:    challenge.IsAdmin,
+		Scope:    strings.Join(challenge.Scopes, " "),
+		Purpose:  mfaChallengePurpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.Itoa(challenge.UserID),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.policy.ChallengeTTL)),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secretKey))
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", constants.TokenCreationFailed, err)
+	}
+	return token, nil
+}
+
+// ParseChallenge verifies the signature, expiry, purpose and tenant of an MFA challenge token.
+func (s *MFAService) ParseChallenge(ctx context.Context, token string) (*MFAChallenge, error) {
+	secretKey, err := s.secrets.GetSecret(ctx, JWTSecretName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.TokenInvalid, err)
+	}
+
+	claims := &mfaChallengeClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("%s: %v", constants.TokenInvalid, token.Header["alg"])
+		}
+		return []byte(secretKey), nil
+	})
+	if err != nil || claims.Purpose != mfaChallengePurpose {
+		return nil, ErrInvalidMFAChallenge
+	}
+
+	// A challenge only completes a login in the tenant it was issued in
+	if CheckTenant(ctx, claims.TenantID) != nil {
+		return nil, ErrInvalidMFAChallenge
+	}
+
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, ErrInvalidMFAChallenge
+	}
+	return &MFAChallenge{TenantID: claims.TenantID, UserID: userID, Username: claims.Username, IsAdmin: claims.Admin, Scopes: strings.Fields(claims.Scope)}, nil
+}
+
+// actor returns the audit actor for a user acting on their own MFA settings
+func (s *MFAService) actor(ctx context.Context, userID int) string {
+	if user, err := s.userService.GetUserByID(ctx, userID); err == nil {
+		return user.Email().String()
+	}
+	return userSubject(userID)
+}
+
+// recoveryCodeEncoding spells recovery codes in lowercase base32, which avoids
+// easily confused characters such as 0/O and 1/l
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// newRecoveryCodes returns new random recovery codes and their hashes
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+		encoded := recoveryCodeEncoding.EncodeToString(b)[:10]
+		codes[i] = encoded[:5] + "-" + encoded[5:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode returns the stored form of a recovery code, ignoring case and dashes
+func hashRecoveryCode(code string) string {
+	return hashToken(strings.ToLower(strings.ReplaceAll(code, "-", "")))
+}
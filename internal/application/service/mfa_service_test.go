@@ -0,0 +1,141 @@
+package service
+
+import (
+	"encoding/base32"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/totp"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+)
+
+// totpCode returns the authenticator code of secret for the time step at
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatalf("DecodeString() unexpected error = %v", err)
+	}
+	return totp.Code(key, at.Unix()/30, 6)
+}
+
+// enableMFA enrolls and confirms an authenticator for a user with a code of
+// the previous time step, returning its secret and the recovery codes
+func enableMFA(t *testing.T, env *testEnv, userID int) (string, []string) {
+	t.Helper()
+
+	enrollment, err := env.mfa.Enroll(testContext(), userID)
+	if err != nil {
+		t.Fatalf("Enroll() unexpected error = %v", err)
+	}
+	codes, err := env.mfa.Confirm(testContext(), userID, totpCode(t, enrollment.Secret, time.Now().Add(-30*time.Second)), "127.0.0.1")
+	if err != nil {
+		t.Fatalf("Confirm() unexpected error = %v", err)
+	}
+	return enrollment.Secret, codes.RecoveryCodes
+}
+
+func TestVerifyCodeAcceptedOnce(t *testing.T) {
+	tests := []struct {
+		name string
+		code func(t *testing.T, secret string, recoveryCodes []string) string
+	}{
+		{
+			name: "Authenticator Code",
+			code: func(t *testing.T, secret string, _ []string) string {
+				return totpCode(t, secret, time.Now())
+			},
+		},
+		{
+			name: "Recovery Code",
+			code: func(_ *testing.T, _ string, recoveryCodes []string) string {
+				return recoveryCodes[0]
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			secret, recoveryCodes := enableMFA(t, env, janeID)
+			code := tt.code(t, secret, recoveryCodes)
+
+			var (
+				wg       sync.WaitGroup
+				mu       sync.Mutex
+				accepted int
+			)
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if env.mfa.VerifyCode(testContext(), janeID, code, "127.0.0.1") == nil {
+						mu.Lock()
+						accepted++
+						mu.Unlock()
+					}
+				}()
+			}
+			wg.Wait()
+
+			if accepted != 1 {
+				t.Errorf("VerifyCode() accepted the same code %d times, want once", accepted)
+			}
+		})
+	}
+}
+
+func TestMFASettingsWrongCodesLockOut(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(t *testing.T, env *testEnv) string
+		change func(env *testEnv, code string) error
+	}{
+		{
+			name: "Confirm",
+			setup: func(t *testing.T, env *testEnv) string {
+				enrollment, err := env.mfa.Enroll(testContext(), janeID)
+				if err != nil {
+					t.Fatalf("Enroll() unexpected error = %v", err)
+				}
+				return totpCode(t, enrollment.Secret, time.Now())
+			},
+			change: func(env *testEnv, code string) error {
+				_, err := env.auth.ConfirmMFA(testContext(), janeID, "jane@example.com", code, "127.0.0.1")
+				return err
+			},
+		},
+		{
+			name: "Disable",
+			setup: func(t *testing.T, env *testEnv) string {
+				secret, _ := enableMFA(t, env, janeID)
+				return totpCode(t, secret, time.Now())
+			},
+			change: func(env *testEnv, code string) error {
+				return env.auth.DisableMFA(testContext(), janeID, "jane@example.com", code, "127.0.0.1")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			code := tt.setup(t, env)
+
+			// The test lockout policy locks after three failures
+			for i := 0; i < 3; i++ {
+				if err := tt.change(env, "000000-wrong"); !errors.Is(err, ErrInvalidMFACode) {
+					t.Fatalf("%s() wrong code error = %v, want %v", tt.name, err, ErrInvalidMFACode)
+				}
+			}
+
+			var tooMany *appErrors.ErrTooManyAttempts
+			if err := tt.change(env, code); !errors.As(err, &tooMany) || !tooMany.Locked {
+				t.Errorf("%s() after lockout error = %v, want a lockout", tt.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,442 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OAuth errors, reported with the error codes of RFC 6749
+var (
+	ErrInvalidClient        = errors.New("invalid client credentials") // Client authentication failed
+	ErrInvalidGrant         = errors.New("invalid refresh token")      // The refresh token is invalid, expired or of another client
+	ErrUnsupportedGrantType = errors.New("unsupported grant type")     // The grant type is not supported
+)
+
+// Register how the OAuth errors are reported to API clients
+func init() {
+	appErrors.Register(ErrInvalidClient, appErrors.Mapping{
+		Status: http.StatusUnauthorized,
+		Code:   appErrors.CodeInvalidClient,
+		Title:  constants.UnauthorizedAccess,
+		Detail: constants.InvalidClientDetail,
+	})
+	appErrors.Register(ErrInvalidGrant, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidGrant,
+		Title:  constants.InvalidRequestFormat,
+		Detail: constants.InvalidGrantDetail,
+	})
+	appErrors.Register(ErrUnsupportedGrantType, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeUnsupportedGrant,
+		Title:  constants.InvalidRequestFormat,
+		Detail: constants.UnsupportedGrantTypeDetail,
+	})
+	appErrors.Register(model.ErrInvalidOAuthClientData, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidRequest,
+		Title:  constants.InvalidRequestFormat,
+	})
+}
+
+// Audit actions of the OAuth service
+const (
+	AuditOAuthClientCreated = "oauth_client.created"
+	AuditOAuthClientDeleted = "oauth_client.deleted"
+)
+
+// OAuth grant types and token type hints (RFC 6749, RFC 7009)
+const (
+	GrantClientCredentials = "client_credentials"
+	GrantRefreshToken      = "refresh_token"
+	HintAccessToken        = "access_token"
+	HintRefreshToken       = "refresh_token"
+)
+
+// oauthClientIDPrefix starts every client ID, so client IDs are easy to recognize
+const oauthClientIDPrefix = "client_"
+
+// OAuthPolicy configures the lifetime of the tokens issued to OAuth clients
+type OAuthPolicy struct {
+	AccessTokenTTL  time.Duration // Lifetime of access tokens
+	RefreshTokenTTL time.Duration // Lifetime of refresh tokens
+}
+
+// OAuthService is a minimal OAuth2 authorization server for registered clients.
+// It issues access tokens with the client_credentials and refresh_token grants,
+// describes tokens (RFC 7662) and revokes them (RFC 7009). Access tokens are
+// JWTs minted by the JWTService; refresh tokens are random and single-use, and
+// only their SHA-256 hashes are stored, like client secrets.
+type OAuthService struct {
+	clientRepo       repository.OAuthClientRepository
+	refreshTokenRepo repository.OAuthRefreshTokenRepository
+	jwtService       *JWTService
+	authService      *AuthService
+	auditService     *AuditService
+	policy           OAuthPolicy
+
+	// mu serializes token requests, so a refresh token can't be used twice by
+	// concurrent requests
+	mu sync.Mutex
+}
+
+// NewOAuthService creates a new OAuth service
+func NewOAuthService(
+	clientRepo repository.OAuthClientRepository,
+	refreshTokenRepo repository.OAuthRefreshTokenRepository,
+	jwtService *JWTService,
+	authService *AuthService,
+	auditService *AuditService,
+	policy OAuthPolicy,
+) *OAuthService {
+	return &OAuthService{
+		clientRepo:       clientRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		jwtService:       jwtService,
+		authService:      authService,
+		auditService:     auditService,
+		policy:           policy,
+	}
+}
+
+// CreateClient registers a new client in the tenant of ctx. The returned
+// secret is shown only once. The client cannot be granted an API scope the
+// caller creating it does not hold.
+func (s *OAuthService) CreateClient(ctx context.Context, request dto.OAuthClientRequest, actor, clientIP string) (*dto.OAuthClientCreatedResponse, error) {
+	tenantID, err := model.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHeldScopes(ctx, request.Scopes); err != nil {
+		return nil, err
+	}
+
+	clientID, err := randomToken(8, hex.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomToken(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := model.NewOAuthClient(tenantID, request.Name, oauthClientIDPrefix+clientID, hashToken(secret), request.Scopes, request.RefreshTokens, actor, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.clientRepo.Save(ctx, client); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditOAuthClientCreated, actor, oauthClientSubject(client.ID()), clientIP, map[string]string{
+		"name":           client.Name(),
+		"client_id":      client.ClientID(),
+		"scopes":         strings.Join(client.Scopes(), " "),
+		"refresh_tokens": strconv.FormatBool(client.IssuesRefreshTokens()),
+	})
+
+	return &dto.OAuthClientCreatedResponse{
+		OAuthClientResponse: dto.ToOAuthClientResponse(client),
+		ClientSecret:        secret,
+	}, nil
+}
+
+// ListClients returns every client registered in the tenant of ctx, without their secrets.
+func (s *OAuthService) ListClients(ctx context.Context) ([]dto.OAuthClientResponse, error) {
+	clients, err := s.clientRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	responses := make([]dto.OAuthClientResponse, 0, len(clients))
+	for _, client := range clients {
+		if CheckTenant(ctx, client.TenantID()) == nil {
+			responses = append(responses, dto.ToOAuthClientResponse(client))
+		}
+	}
+	return responses, nil
+}
+
+// DeleteClient removes a client along with its refresh tokens.
+// Access tokens already issued to it remain valid until they expire.
+func (s *OAuthService) DeleteClient(ctx context.Context, id int, actor, clientIP string) error {
+	client, err := s.clientRepo.FindByID(ctx, id)
+	if err != nil || CheckTenant(ctx, client.TenantID()) != nil {
+		return &appErrors.ErrNotFound{Resource: "OAuth client", ID: id}
+	}
+
+	if err := s.refreshTokenRepo.DeleteByClientID(ctx, client.ClientID()); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	if err := s.clientRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditOAuthClientDeleted, actor, oauthClientSubject(id), clientIP, map[string]string{
+		"client_id": client.ClientID(),
+	})
+	return nil
+}
+
+// AuthenticateClient returns the client with the given credentials, or
+// ErrInvalidClient if the client is unknown, registered in another tenant
+// than ctx, or the secret is wrong.
+func (s *OAuthService) AuthenticateClient(ctx context.Context, clientID, secret string) (*model.OAuthClient, error) {
+	if clientID == "" || secret == "" {
+		return nil, ErrInvalidClient
+	}
+
+	client, err := s.clientRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, ErrInvalidClient
+	}
+
+	hash := hashToken(secret)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(client.SecretHash())) != 1 || CheckTenant(ctx, client.TenantID()) != nil {
+		return nil, ErrInvalidClient
+	}
+	return client, nil
+}
+
+// Token issues an access token to an authenticated client, and a refresh token
+// to clients registered for them. With the client_credentials grant, the client is granted the requested scopes,
+// or all of its scopes if none are requested. With the refresh_token grant, the
+// refresh token is used up and the scopes it was issued with are granted again,
+// narrowed to the requested ones if any.
+func (s *OAuthService) Token(ctx context.Context, client *model.OAuthClient, request dto.OAuthTokenRequest) (*dto.OAuthTokenResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var allowed []string
+	var refreshToken *model.OAuthRefreshToken
+	switch request.GrantType {
+	case GrantClientCredentials:
+		allowed = client.Scopes()
+	case GrantRefreshToken:
+		token, err := s.findRefreshToken(ctx, client, request.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		allowed = token.Scopes()
+		refreshToken = token
+	default:
+		return nil, ErrUnsupportedGrantType
+	}
+
+	granted, err := model.NarrowScopes(allowed, request.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refresh tokens are single-use, a new one is issued below
+	if refreshToken != nil {
+		refreshToken.Revoke(time.Now())
+		if err := s.refreshTokenRepo.Save(ctx, refreshToken); err != nil {
+			return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+		}
+	}
+
+	return s.issueTokens(ctx, client, allowed, granted)
+}
+
+// findRefreshToken returns an active refresh token of the client
+func (s *OAuthService) findRefreshToken(ctx context.Context, client *model.OAuthClient, rawToken string) (*model.OAuthRefreshToken, error) {
+	if rawToken == "" {
+		return nil, &appErrors.ErrInvalidRequest{Field: "refresh_token", Message: "is required"}
+	}
+
+	token, err := s.refreshTokenRepo.FindByTokenHash(ctx, hashToken(rawToken))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, ErrInvalidGrant
+	}
+	if !token.IsActive(time.Now()) || token.ClientID() != client.ClientID() {
+		return nil, ErrInvalidGrant
+	}
+	return token, nil
+}
+
+// issueTokens mints an access token with the granted scopes and, for clients
+// registered for them, a refresh token that can later obtain access tokens with
+// any of the allowed scopes. Other clients authenticate again with their secret
+// for the next access token, as RFC 6749 section 4.4.3 recommends.
+func (s *OAuthService) issueTokens(ctx context.Context, client *model.OAuthClient, allowed, granted []string) (*dto.OAuthTokenResponse, error) {
+	accessToken, err := s.jwtService.GenerateClientToken(ctx, client.ClientID(), granted, s.policy.AccessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.OAuthTokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(s.policy.AccessTokenTTL.Seconds()),
+		Scope:       strings.Join(granted, " "),
+	}
+	if !client.IssuesRefreshTokens() {
+		return response, nil
+	}
+
+	refreshToken, err := randomToken(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+	stored := model.NewOAuthRefreshToken(hashToken(refreshToken), client.ClientID(), allowed, time.Now(), s.policy.RefreshTokenTTL)
+	if err := s.refreshTokenRepo.Save(ctx, stored); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	response.RefreshToken = refreshToken
+	return response, nil
+}
+
+// Introspect describes a token for an authenticated client (RFC 7662).
+// Access tokens of clients are described to any client and those of users only
+// to clients allowed the tokens:introspect scope. Refresh tokens are described
+// only to the client they were issued to. hint, "access_token" or "refresh_token", only decides
+// which kind of token is looked up first.
+func (s *OAuthService) Introspect(ctx context.Context, client *model.OAuthClient, rawToken, hint string) (*dto.OAuthIntrospectionResponse, error) {
+	if hint == HintRefreshToken {
+		response, err := s.introspectRefreshToken(ctx, client, rawToken)
+		if err != nil || response.Active {
+			return response, err
+		}
+		return s.introspectAccessToken(ctx, client, rawToken), nil
+	}
+
+	if response := s.introspectAccessToken(ctx, client, rawToken); response.Active {
+		return response, nil
+	}
+	return s.introspectRefreshToken(ctx, client, rawToken)
+}
+
+// introspectAccessToken describes an access token of a user or client.
+// User tokens are only active while the API would accept them and only for
+// clients allowed to introspect them, and tokens issued in another tenant are
+// never active.
+func (s *OAuthService) introspectAccessToken(ctx context.Context, client *model.OAuthClient, rawToken string) *dto.OAuthIntrospectionResponse {
+	claims, err := s.jwtService.ValidateToken(ctx, rawToken)
+	if err != nil || CheckTenant(ctx, claims.TenantID) != nil {
+		return &dto.OAuthIntrospectionResponse{Active: false}
+	}
+
+	response := &dto.OAuthIntrospectionResponse{
+		Active:    true,
+		Scope:     claims.Scope,
+		ClientID:  claims.ClientID,
+		Username:  claims.Username,
+		TokenType: "Bearer",
+		Exp:       claims.ExpiresAt.Unix(),
+		Iat:       claims.IssuedAt.Unix(),
+		Sub:       claims.Subject,
+		Jti:       claims.ID,
+	}
+	if claims.ClientID != "" {
+		return response
+	}
+
+	if !slices.Contains(client.Scopes(), model.ScopeTokensIntrospect) {
+		return &dto.OAuthIntrospectionResponse{Active: false}
+	}
+	if err := s.authService.CheckToken(ctx, claims); err != nil {
+		return &dto.OAuthIntrospectionResponse{Active: false}
+	}
+	return response
+}
+
+// introspectRefreshToken describes a refresh token of the client
+func (s *OAuthService) introspectRefreshToken(ctx context.Context, client *model.OAuthClient, rawToken string) (*dto.OAuthIntrospectionResponse, error) {
+	token, err := s.refreshTokenRepo.FindByTokenHash(ctx, hashToken(rawToken))
+	if err != nil {
+		return &dto.OAuthIntrospectionResponse{Active: false}, ctx.Err()
+	}
+	if !token.IsActive(time.Now()) || token.ClientID() != client.ClientID() {
+		return &dto.OAuthIntrospectionResponse{Active: false}, nil
+	}
+
+	return &dto.OAuthIntrospectionResponse{
+		Active:   true,
+		Scope:    strings.Join(token.Scopes(), " "),
+		ClientID: token.ClientID(),
+		Sub:      token.ClientID(),
+		Exp:      token.ExpiresAt().Unix(),
+		Iat:      token.IssuedAt().Unix(),
+	}, nil
+}
+
+// Revoke revokes a token issued to an authenticated client (RFC 7009).
+// Unknown tokens and tokens of other clients are ignored, as the RFC requires
+// the same response for them as for a successful revocation. hint only decides
+// which kind of token is looked up first.
+func (s *OAuthService) Revoke(ctx context.Context, client *model.OAuthClient, rawToken, hint string) error {
+	revokers := []func(context.Context, *model.OAuthClient, string) (bool, error){s.revokeAccessToken, s.revokeRefreshToken}
+	if hint == HintRefreshToken {
+		slices.Reverse(revokers)
+	}
+
+	for _, revoke := range revokers {
+		if found, err := revoke(ctx, client, rawToken); found || err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revokeAccessToken revokes an access token of the client, reporting whether rawToken is an access token
+func (s *OAuthService) revokeAccessToken(ctx context.Context, client *model.OAuthClient, rawToken string) (bool, error) {
+	claims, err := s.jwtService.ValidateToken(ctx, rawToken)
+	if err != nil {
+		return false, nil
+	}
+
+	if claims.ClientID != client.ClientID() {
+		return true, nil
+	}
+
+	if err := s.jwtService.RevokeToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		return true, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	return true, nil
+}
+
+// revokeRefreshToken revokes a refresh token of the client, reporting whether rawToken is a refresh token
+func (s *OAuthService) revokeRefreshToken(ctx context.Context, client *model.OAuthClient, rawToken string) (bool, error) {
+	token, err := s.refreshTokenRepo.FindByTokenHash(ctx, hashToken(rawToken))
+	if err != nil {
+		return false, ctx.Err()
+	}
+	if token.ClientID() != client.ClientID() {
+		return true, nil
+	}
+
+	token.Revoke(time.Now())
+	if err := s.refreshTokenRepo.Save(ctx, token); err != nil {
+		return true, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	return true, nil
+}
+
+// oauthClientSubject returns the audit subject identifying an OAuth client
+func oauthClientSubject(id int) string {
+	return "oauth_client:" + strconv.Itoa(id)
+}
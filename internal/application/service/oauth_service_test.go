@@ -0,0 +1,131 @@
+package service
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+)
+
+// newTestOAuthService returns an OAuth service issuing tokens through the services of env
+func newTestOAuthService(env *testEnv) *OAuthService {
+	return NewOAuthService(inmemory.NewInMemoryOAuthClientRepository(), inmemory.NewInMemoryOAuthRefreshTokenRepository(), env.jwt, env.auth, env.audit,
+		OAuthPolicy{AccessTokenTTL: time.Minute, RefreshTokenTTL: time.Hour})
+}
+
+// createClient registers a client allowed scopes and returns it authenticated
+func createClient(t *testing.T, service *OAuthService, refreshTokens bool, scopes ...string) *model.OAuthClient {
+	t.Helper()
+
+	request := dto.OAuthClientRequest{Name: "reports", Scopes: scopes, RefreshTokens: refreshTokens}
+	created, err := service.CreateClient(adminContext(), request, "admin", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("CreateClient() unexpected error = %v", err)
+	}
+	client, err := service.AuthenticateClient(testContext(), created.ClientID, created.ClientSecret)
+	if err != nil {
+		t.Fatalf("AuthenticateClient() unexpected error = %v", err)
+	}
+	return client
+}
+
+func TestRefreshTokenUsedOnce(t *testing.T) {
+	service := newTestOAuthService(newTestEnv(t))
+	client := createClient(t, service, true, model.ScopeUsersRead)
+
+	issued, err := service.Token(testContext(), client, dto.OAuthTokenRequest{GrantType: GrantClientCredentials})
+	if err != nil {
+		t.Fatalf("Token() unexpected error = %v", err)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		accepted int
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			request := dto.OAuthTokenRequest{GrantType: GrantRefreshToken, RefreshToken: issued.RefreshToken}
+			if _, err := service.Token(testContext(), client, request); err == nil {
+				mu.Lock()
+				accepted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if accepted != 1 {
+		t.Errorf("Token() accepted the same refresh token %d times, want once", accepted)
+	}
+}
+
+func TestClientCredentialsRefreshTokens(t *testing.T) {
+	tests := []struct {
+		name          string
+		refreshTokens bool
+	}{
+		{"Client Issued Refresh Tokens", true},
+		{"Other Client", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newTestOAuthService(newTestEnv(t))
+			client := createClient(t, service, tt.refreshTokens, model.ScopeUsersRead)
+
+			issued, err := service.Token(testContext(), client, dto.OAuthTokenRequest{GrantType: GrantClientCredentials})
+			if err != nil {
+				t.Fatalf("Token() unexpected error = %v", err)
+			}
+			if issued.AccessToken == "" || (issued.RefreshToken != "") != tt.refreshTokens {
+				t.Errorf("Token() refresh token = %q, want one %v", issued.RefreshToken, tt.refreshTokens)
+			}
+		})
+	}
+}
+
+func TestIntrospectUserToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		scopes     []string
+		wantActive bool
+	}{
+		{
+			name:       "Client Allowed To Introspect",
+			scopes:     []string{model.ScopeTokensIntrospect},
+			wantActive: true,
+		},
+		{
+			name:   "Other Client",
+			scopes: []string{model.ScopeUsersRead},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			service := newTestOAuthService(env)
+			client := createClient(t, service, false, tt.scopes...)
+
+			env.setPassword(t, janeID, "jane's password")
+			login, err := env.auth.Login(testContext(), "jane@example.com", "jane's password", "", "127.0.0.1", "test")
+			if err != nil {
+				t.Fatalf("Login() unexpected error = %v", err)
+			}
+
+			response, err := service.Introspect(testContext(), client, login.Token, HintAccessToken)
+			if err != nil {
+				t.Fatalf("Introspect() unexpected error = %v", err)
+			}
+			if response.Active != tt.wantActive {
+				t.Errorf("Introspect() active = %v, want %v", response.Active, tt.wantActive)
+			}
+		})
+	}
+}
@@ -0,0 +1,663 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrRoleRequired is returned when the caller's role in an organization or team does not allow an action
+var ErrRoleRequired = errors.New("role does not allow this action")
+
+// Register how the organization errors are reported to API clients
+func init() {
+	appErrors.Register(ErrRoleRequired, appErrors.Mapping{
+		Status: http.StatusForbidden,
+		Code:   appErrors.CodeForbidden,
+		Title:  constants.RoleRequired,
+		Detail: constants.RoleRequiredDetail,
+	})
+	appErrors.Register(model.ErrInvalidOrganizationData, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidRequest,
+		Title:  constants.InvalidRequestFormat,
+	})
+	appErrors.Register(model.ErrInvalidRole, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidRequest,
+		Title:  constants.InvalidRequestFormat,
+	})
+	appErrors.Register(model.ErrAlreadyMember, appErrors.Mapping{
+		Status: http.StatusConflict,
+		Code:   appErrors.CodeAlreadyMember,
+		Title:  constants.AlreadyMember,
+		Detail: constants.AlreadyMemberDetail,
+	})
+	appErrors.Register(model.ErrNotMember, appErrors.Mapping{
+		Status: http.StatusNotFound,
+		Code:   appErrors.CodeNotFound,
+		Title:  constants.ResourceNotFound,
+	})
+	appErrors.Register(model.ErrLastOwner, appErrors.Mapping{
+		Status: http.StatusConflict,
+		Code:   appErrors.CodeLastOwner,
+		Title:  constants.LastOwner,
+		Detail: constants.LastOwnerDetail,
+	})
+}
+
+// Audit actions of the organization service
+const (
+	AuditOrganizationCreated       = "org.created"
+	AuditOrganizationDeleted       = "org.deleted"
+	AuditOrganizationRoleChanged   = "org.role_changed"
+	AuditOrganizationMemberRemoved = "org.member_removed"
+	AuditTeamCreated               = "team.created"
+	AuditTeamDeleted               = "team.deleted"
+	AuditTeamRoleChanged           = "team.role_changed"
+	AuditTeamMemberRemoved         = "team.member_removed"
+)
+
+// OrganizationService manages organizations, their teams and memberships.
+// Every operation acts for a user of the tenant of ctx and is authorized by
+// their membership: organizations and teams are only visible to members of
+// the organization, and are reported as not found to anyone else.
+//
+// Owners and admins of an organization act as owners of all its teams. Other
+// members manage a team according to their role in it: admins manage its
+// members who are not owners, owners manage everything. Anyone may leave an
+// organization or team, as long as it keeps an owner.
+type OrganizationService struct {
+	organizationRepo repository.OrganizationRepository
+	teamRepo         repository.TeamRepository
+	invitationRepo   repository.InvitationRepository
+	userService      *domainService.UserService
+	auditService     *AuditService
+
+	// mu serializes membership changes, so that concurrent changes of an
+	// organization and its teams cannot overwrite each other or leave a team
+	// member outside the organization
+	mu sync.Mutex
+}
+
+// NewOrganizationService creates a new organization service
+func NewOrganizationService(
+	organizationRepo repository.OrganizationRepository,
+	teamRepo repository.TeamRepository,
+	invitationRepo repository.InvitationRepository,
+	userService *domainService.UserService,
+	auditService *AuditService,
+) *OrganizationService {
+	return &OrganizationService{
+		organizationRepo: organizationRepo,
+		teamRepo:         teamRepo,
+		invitationRepo:   invitationRepo,
+		userService:      userService,
+		auditService:     auditService,
+	}
+}
+
+// List returns the organizations a user is a member of.
+func (s *OrganizationService) List(ctx context.Context, userID int) ([]dto.OrganizationResponse, error) {
+	organizations, err := s.organizationRepo.FindByMember(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	responses := make([]dto.OrganizationResponse, 0, len(organizations))
+	for _, organization := range organizations {
+		member, _ := organization.Member(userID)
+		responses = append(responses, dto.ToOrganizationResponse(organization, member.Role))
+	}
+	return responses, nil
+}
+
+// Get returns an organization the user is a member of.
+func (s *OrganizationService) Get(ctx context.Context, userID, organizationID int) (*dto.OrganizationResponse, error) {
+	organization, member, err := s.organization(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToOrganizationResponse(organization, member.Role)
+	return &response, nil
+}
+
+// Create creates an organization in the tenant of ctx, owned by the user.
+func (s *OrganizationService) Create(ctx context.Context, userID int, request dto.OrganizationRequest, actor, clientIP string) (*dto.OrganizationResponse, error) {
+	tenantID, err := model.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := model.NewOrganization(tenantID, request.Name, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.organizationRepo.Save(ctx, organization); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditOrganizationCreated, actor, organizationSubject(organization.ID()), clientIP, map[string]string{
+		"name": organization.Name(),
+	})
+
+	response := dto.ToOrganizationResponse(organization, model.RoleOwner)
+	return &response, nil
+}
+
+// Update renames an organization. Only its owners and admins may.
+func (s *OrganizationService) Update(ctx context.Context, userID, organizationID int, request dto.OrganizationRequest) (*dto.OrganizationResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	organization, member, err := s.organization(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Role.AtLeast(model.RoleAdmin) {
+		return nil, ErrRoleRequired
+	}
+
+	if err := organization.Rename(request.Name, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.organizationRepo.Save(ctx, organization); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	response := dto.ToOrganizationResponse(organization, member.Role)
+	return &response, nil
+}
+
+// Delete deletes an organization with its teams and their invitations.
+// Only its owners may.
+func (s *OrganizationService) Delete(ctx context.Context, userID, organizationID int, actor, clientIP string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	organization, member, err := s.organization(ctx, userID, organizationID)
+	if err != nil {
+		return err
+	}
+	if member.Role != model.RoleOwner {
+		return ErrRoleRequired
+	}
+
+	teams, err := s.teamRepo.FindByOrganizationID(ctx, organization.ID())
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	for _, team := range teams {
+		if err := s.deleteTeam(ctx, team); err != nil {
+			return err
+		}
+	}
+	if err := s.organizationRepo.Delete(ctx, organization.ID()); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditOrganizationDeleted, actor, organizationSubject(organization.ID()), clientIP, map[string]string{
+		"name": organization.Name(),
+	})
+	return nil
+}
+
+// ListMembers returns the members of an organization the user is a member of.
+func (s *OrganizationService) ListMembers(ctx context.Context, userID, organizationID int) ([]dto.MemberResponse, error) {
+	organization, _, err := s.organization(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	return s.memberResponses(ctx, organization.Members()), nil
+}
+
+// ChangeMemberRole changes the role of a member of an organization.
+// Only its owners may, and the last owner cannot be demoted.
+func (s *OrganizationService) ChangeMemberRole(ctx context.Context, userID, organizationID, memberID int, role model.MemberRole, actor, clientIP string) (*dto.MemberResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	organization, caller, err := s.organization(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if caller.Role != model.RoleOwner {
+		return nil, ErrRoleRequired
+	}
+
+	previous, ok := organization.Member(memberID)
+	if !ok {
+		return nil, model.ErrNotMember
+	}
+	if err := organization.ChangeRole(memberID, role, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.organizationRepo.Save(ctx, organization); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditOrganizationRoleChanged, actor, organizationSubject(organization.ID()), clientIP, map[string]string{
+		"user": strconv.Itoa(memberID),
+		"from": string(previous.Role),
+		"to":   string(role),
+	})
+
+	member, _ := organization.Member(memberID)
+	response := s.memberResponse(ctx, member)
+	return &response, nil
+}
+
+// RemoveMember removes a member from an organization and all its teams.
+// Owners and admins may remove members, though only owners may remove owners,
+// and members may leave. It fails if the member is the last owner of the
+// organization or of one of its teams.
+func (s *OrganizationService) RemoveMember(ctx context.Context, userID, organizationID, memberID int, actor, clientIP string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	organization, caller, err := s.organization(ctx, userID, organizationID)
+	if err != nil {
+		return err
+	}
+	member, ok := organization.Member(memberID)
+	if !ok {
+		return model.ErrNotMember
+	}
+	if (memberID != userID && !caller.Role.AtLeast(model.RoleAdmin)) || !caller.Role.AtLeast(member.Role) {
+		return ErrRoleRequired
+	}
+
+	// Remove the member from every team first, so that nothing is saved if
+	// they are the last owner of one
+	now := time.Now()
+	if err := organization.RemoveMember(memberID, now); err != nil {
+		return err
+	}
+	teams, err := s.teamRepo.FindByOrganizationID(ctx, organization.ID())
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	var changed []*model.Team
+	for _, team := range teams {
+		if _, ok := team.Member(memberID); !ok {
+			continue
+		}
+		if err := team.RemoveMember(memberID, now); err != nil {
+			return err
+		}
+		changed = append(changed, team)
+	}
+
+	for _, team := range changed {
+		if err := s.teamRepo.Save(ctx, team); err != nil {
+			return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+		}
+	}
+	if err := s.organizationRepo.Save(ctx, organization); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditOrganizationMemberRemoved, actor, organizationSubject(organization.ID()), clientIP, map[string]string{
+		"user": strconv.Itoa(memberID),
+		"role": string(member.Role),
+	})
+	return nil
+}
+
+// ListTeams returns the teams of an organization the user is a member of.
+func (s *OrganizationService) ListTeams(ctx context.Context, userID, organizationID int) ([]dto.TeamResponse, error) {
+	_, caller, err := s.organization(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.FindByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	responses := make([]dto.TeamResponse, 0, len(teams))
+	for _, team := range teams {
+		responses = append(responses, dto.ToTeamResponse(team, teamRole(caller, team)))
+	}
+	return responses, nil
+}
+
+// GetTeam returns a team of an organization the user is a member of.
+func (s *OrganizationService) GetTeam(ctx context.Context, userID, organizationID, teamID int) (*dto.TeamResponse, error) {
+	_, team, role, err := s.team(ctx, userID, organizationID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToTeamResponse(team, role)
+	return &response, nil
+}
+
+// CreateTeam creates a team in an organization, owned by the user.
+// Only owners and admins of the organization may.
+func (s *OrganizationService) CreateTeam(ctx context.Context, userID, organizationID int, request dto.TeamRequest, actor, clientIP string) (*dto.TeamResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	organization, caller, err := s.organization(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.Role.AtLeast(model.RoleAdmin) {
+		return nil, ErrRoleRequired
+	}
+
+	team, err := model.NewTeam(organization.ID(), request.Name, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.teamRepo.Save(ctx, team); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditTeamCreated, actor, teamSubject(team.ID()), clientIP, map[string]string{
+		"organization": strconv.Itoa(organization.ID()),
+		"name":         team.Name(),
+	})
+
+	response := dto.ToTeamResponse(team, model.RoleOwner)
+	return &response, nil
+}
+
+// UpdateTeam renames a team. Only its owners and admins may.
+func (s *OrganizationService) UpdateTeam(ctx context.Context, userID, organizationID, teamID int, request dto.TeamRequest) (*dto.TeamResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, team, role, err := s.team(ctx, userID, organizationID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !role.AtLeast(model.RoleAdmin) {
+		return nil, ErrRoleRequired
+	}
+
+	if err := team.Rename(request.Name, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.teamRepo.Save(ctx, team); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	response := dto.ToTeamResponse(team, role)
+	return &response, nil
+}
+
+// DeleteTeam deletes a team with its invitations. Only its owners may.
+func (s *OrganizationService) DeleteTeam(ctx context.Context, userID, organizationID, teamID int, actor, clientIP string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, team, role, err := s.team(ctx, userID, organizationID, teamID)
+	if err != nil {
+		return err
+	}
+	if role != model.RoleOwner {
+		return ErrRoleRequired
+	}
+
+	if err := s.deleteTeam(ctx, team); err != nil {
+		return err
+	}
+
+	s.auditService.Record(ctx, AuditTeamDeleted, actor, teamSubject(team.ID()), clientIP, map[string]string{
+		"organization": strconv.Itoa(organizationID),
+		"name":         team.Name(),
+	})
+	return nil
+}
+
+// ListTeamMembers returns the members of a team of an organization the user is a member of.
+func (s *OrganizationService) ListTeamMembers(ctx context.Context, userID, organizationID, teamID int) ([]dto.MemberResponse, error) {
+	_, team, _, err := s.team(ctx, userID, organizationID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return s.memberResponses(ctx, team.Members()), nil
+}
+
+// ChangeTeamMemberRole changes the role of a member of a team. Owners and
+// admins of the team may, though only owners may change the role of an owner
+// or make a member owner, and the last owner cannot be demoted.
+func (s *OrganizationService) ChangeTeamMemberRole(ctx context.Context, userID, organizationID, teamID, memberID int, role model.MemberRole, actor, clientIP string) (*dto.MemberResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, team, callerRole, err := s.team(ctx, userID, organizationID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	previous, ok := team.Member(memberID)
+	if !ok {
+		if !callerRole.AtLeast(model.RoleAdmin) {
+			return nil, ErrRoleRequired
+		}
+		return nil, model.ErrNotMember
+	}
+	if !callerRole.AtLeast(model.RoleAdmin) || !callerRole.AtLeast(previous.Role) || !callerRole.AtLeast(role) {
+		return nil, ErrRoleRequired
+	}
+
+	if err := team.ChangeRole(memberID, role, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.teamRepo.Save(ctx, team); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditTeamRoleChanged, actor, teamSubject(team.ID()), clientIP, map[string]string{
+		"user": strconv.Itoa(memberID),
+		"from": string(previous.Role),
+		"to":   string(role),
+	})
+
+	member, _ := team.Member(memberID)
+	response := s.memberResponse(ctx, member)
+	return &response, nil
+}
+
+// RemoveTeamMember removes a member from a team. Owners and admins of the
+// team may, though only owners may remove owners, and members may leave. The
+// last owner cannot be removed. The user stays a member of the organization.
+func (s *OrganizationService) RemoveTeamMember(ctx context.Context, userID, organizationID, teamID, memberID int, actor, clientIP string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, team, callerRole, err := s.team(ctx, userID, organizationID, teamID)
+	if err != nil {
+		return err
+	}
+	member, ok := team.Member(memberID)
+	if !ok {
+		if !callerRole.AtLeast(model.RoleAdmin) {
+			return ErrRoleRequired
+		}
+		return model.ErrNotMember
+	}
+	if (memberID != userID && !callerRole.AtLeast(model.RoleAdmin)) || !callerRole.AtLeast(member.Role) {
+		return ErrRoleRequired
+	}
+
+	if err := team.RemoveMember(memberID, time.Now()); err != nil {
+		return err
+	}
+	if err := s.teamRepo.Save(ctx, team); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditTeamMemberRemoved, actor, teamSubject(team.ID()), clientIP, map[string]string{
+		"user": strconv.Itoa(memberID),
+		"role": string(member.Role),
+	})
+	return nil
+}
+
+// TeamForMember returns a team of an organization the user is a member of,
+// with the organization and the user's role in the team, see teamRole.
+// Other services authorize their actions on the team with it.
+func (s *OrganizationService) TeamForMember(ctx context.Context, userID, organizationID, teamID int) (*model.Organization, *model.Team, model.MemberRole, error) {
+	return s.team(ctx, userID, organizationID, teamID)
+}
+
+// FindTeam returns a team of an organization of the tenant of ctx, with the
+// organization, whoever is a member of them.
+func (s *OrganizationService) FindTeam(ctx context.Context, organizationID, teamID int) (*model.Organization, *model.Team, error) {
+	organization, err := s.organizationRepo.FindByID(ctx, organizationID)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, ctxErr
+		}
+		return nil, nil, &appErrors.ErrNotFound{Resource: "organization", ID: organizationID}
+	}
+
+	team, err := s.teamRepo.FindByID(ctx, teamID)
+	if err != nil || team.OrganizationID() != organization.ID() {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, ctxErr
+		}
+		return nil, nil, &appErrors.ErrNotFound{Resource: "team", ID: teamID}
+	}
+	return organization, team, nil
+}
+
+// JoinTeam adds a user to a team in the given role, and to its organization
+// as a member if they are not in it yet, such as when they accept an invitation.
+func (s *OrganizationService) JoinTeam(ctx context.Context, userID, organizationID, teamID int, role model.MemberRole) (*dto.TeamResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	organization, team, err := s.FindTeam(ctx, organizationID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if _, ok := organization.Member(userID); !ok {
+		if err := organization.AddMember(userID, model.RoleMember, now); err != nil {
+			return nil, err
+		}
+	}
+	if err := team.AddMember(userID, role, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.organizationRepo.Save(ctx, organization); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	if err := s.teamRepo.Save(ctx, team); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	member, _ := organization.Member(userID)
+	response := dto.ToTeamResponse(team, teamRole(member, team))
+	return &response, nil
+}
+
+// organization returns an organization of the tenant of ctx with the user's
+// membership, reporting it as not found if the user is not a member
+func (s *OrganizationService) organization(ctx context.Context, userID, organizationID int) (*model.Organization, model.Member, error) {
+	organization, err := s.organizationRepo.FindByID(ctx, organizationID)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, model.Member{}, ctxErr
+		}
+		return nil, model.Member{}, &appErrors.ErrNotFound{Resource: "organization", ID: organizationID}
+	}
+
+	member, ok := organization.Member(userID)
+	if !ok {
+		return nil, model.Member{}, &appErrors.ErrNotFound{Resource: "organization", ID: organizationID}
+	}
+	return organization, member, nil
+}
+
+// team returns a team of an organization the user is a member of, with the
+// organization and the user's role in the team, see teamRole
+func (s *OrganizationService) team(ctx context.Context, userID, organizationID, teamID int) (*model.Organization, *model.Team, model.MemberRole, error) {
+	organization, caller, err := s.organization(ctx, userID, organizationID)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	team, err := s.teamRepo.FindByID(ctx, teamID)
+	if err != nil || team.OrganizationID() != organization.ID() {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, "", ctxErr
+		}
+		return nil, nil, "", &appErrors.ErrNotFound{Resource: "team", ID: teamID}
+	}
+	return organization, team, teamRole(caller, team), nil
+}
+
+// deleteTeam deletes a team with its invitations
+func (s *OrganizationService) deleteTeam(ctx context.Context, team *model.Team) error {
+	invitations, err := s.invitationRepo.FindByTeamID(ctx, team.ID())
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	for _, invitation := range invitations {
+		if err := s.invitationRepo.Delete(ctx, invitation.ID()); err != nil {
+			return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+		}
+	}
+	if err := s.teamRepo.Delete(ctx, team.ID()); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	return nil
+}
+
+// memberResponses describes members with their users
+func (s *OrganizationService) memberResponses(ctx context.Context, members []model.Member) []dto.MemberResponse {
+	responses := make([]dto.MemberResponse, 0, len(members))
+	for _, member := range members {
+		responses = append(responses, s.memberResponse(ctx, member))
+	}
+	return responses
+}
+
+// memberResponse describes a member with their user, if it still exists
+func (s *OrganizationService) memberResponse(ctx context.Context, member model.Member) dto.MemberResponse {
+	user, err := s.userService.GetUserByID(ctx, member.UserID)
+	if err != nil {
+		return dto.ToMemberResponse(member, nil)
+	}
+	return dto.ToMemberResponse(member, user)
+}
+
+// teamRole returns the role in a team of a member of its organization:
+// organization owners and admins act as team owners, other members have
+// their role in the team, or none if they are not in it
+func teamRole(organizationMember model.Member, team *model.Team) model.MemberRole {
+	if organizationMember.Role.AtLeast(model.RoleAdmin) {
+		return model.RoleOwner
+	}
+	member, _ := team.Member(organizationMember.UserID)
+	return member.Role
+}
+
+// organizationSubject returns the audit subject identifying an organization
+func organizationSubject(id int) string {
+	return "organization:" + strconv.Itoa(id)
+}
+
+// teamSubject returns the audit subject identifying a team
+func teamSubject(id int) string {
+	return "team:" + strconv.Itoa(id)
+}
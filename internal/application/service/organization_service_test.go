@@ -0,0 +1,276 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+)
+
+// testOrganizations holds the organization and invitation services of a testEnv
+type testOrganizations struct {
+	*testEnv
+	organizations *OrganizationService
+	invitations   *InvitationService
+}
+
+// newTestOrganizations returns the organization and invitation services of a fresh application
+func newTestOrganizations(t *testing.T) *testOrganizations {
+	t.Helper()
+
+	env := newTestEnv(t)
+	invitationRepo := inmemory.NewInMemoryInvitationRepository()
+	organizations := NewOrganizationService(inmemory.NewInMemoryOrganizationRepository(), inmemory.NewInMemoryTeamRepository(),
+		invitationRepo, env.users, env.audit)
+	invitations := NewInvitationService(organizations, invitationRepo, env.users, env.mailer, env.audit,
+		InvitationPolicy{URL: "https://app.example.com/accept-invitation", TTL: time.Hour})
+	return &testOrganizations{testEnv: env, organizations: organizations, invitations: invitations}
+}
+
+// newVerifiedUser creates a user with a verified email address
+func (env *testOrganizations) newVerifiedUser(t *testing.T, name, email string) int {
+	t.Helper()
+
+	user, err := env.users.CreateUser(testContext(), domainService.UserData{Name: name, Email: email, Age: 30})
+	if err != nil {
+		t.Fatalf("CreateUser() unexpected error = %v", err)
+	}
+	if _, err := env.users.VerifyEmail(testContext(), user.ID(), email); err != nil {
+		t.Fatalf("VerifyEmail() unexpected error = %v", err)
+	}
+	return user.ID()
+}
+
+// setUp creates an organization with a team, both owned by ownerID
+func (env *testOrganizations) setUp(t *testing.T, ownerID int) (int, int) {
+	t.Helper()
+
+	organization, err := env.organizations.Create(testContext(), ownerID, dto.OrganizationRequest{Name: "Acme"}, "owner", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+	team, err := env.organizations.CreateTeam(testContext(), ownerID, organization.ID, dto.TeamRequest{Name: "Platform"}, "owner", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("CreateTeam() unexpected error = %v", err)
+	}
+	return organization.ID, team.ID
+}
+
+// invite sends an invitation to the team in the given role and returns its token
+func (env *testOrganizations) invite(t *testing.T, inviterID, organizationID, teamID int, email string, role model.MemberRole) string {
+	t.Helper()
+
+	request := dto.InvitationRequest{Email: email, Role: string(role)}
+	if _, err := env.invitations.Create(testContext(), inviterID, organizationID, teamID, request, "inviter", "127.0.0.1"); err != nil {
+		t.Fatalf("Create() invitation unexpected error = %v", err)
+	}
+	return env.mailer.lastToken(t)
+}
+
+// join adds a user to the team in the given role through an invitation of inviterID
+func (env *testOrganizations) join(t *testing.T, inviterID, organizationID, teamID, userID int, role model.MemberRole) {
+	t.Helper()
+
+	user, err := env.users.GetUserByID(testContext(), userID)
+	if err != nil {
+		t.Fatalf("GetUserByID() unexpected error = %v", err)
+	}
+	token := env.invite(t, inviterID, organizationID, teamID, user.Email().String(), role)
+	if _, err := env.invitations.Accept(testContext(), userID, token, "invitee", "127.0.0.1"); err != nil {
+		t.Fatalf("Accept() unexpected error = %v", err)
+	}
+}
+
+func TestOrganizationRoles(t *testing.T) {
+	// Jane owns the organization, Admin administers it and John is a member
+	const (
+		owner  = "Owner"
+		admin  = "Admin"
+		member = "Member"
+	)
+
+	tests := []struct {
+		name   string
+		caller string
+		action func(env *testOrganizations, callerID, organizationID, teamID, memberID int) error
+		want   error
+	}{
+		{
+			name:   "Owner Renames Organization",
+			caller: owner,
+			action: renameOrganization,
+		},
+		{
+			name:   "Admin Renames Organization",
+			caller: admin,
+			action: renameOrganization,
+		},
+		{
+			name:   "Member Renames Organization",
+			caller: member,
+			action: renameOrganization,
+			want:   ErrRoleRequired,
+		},
+		{
+			name:   "Admin Creates Team",
+			caller: admin,
+			action: createTeam,
+		},
+		{
+			name:   "Member Creates Team",
+			caller: member,
+			action: createTeam,
+			want:   ErrRoleRequired,
+		},
+		{
+			name:   "Owner Changes Role",
+			caller: owner,
+			action: promoteMember,
+		},
+		{
+			name:   "Admin Changes Role",
+			caller: admin,
+			action: promoteMember,
+			want:   ErrRoleRequired,
+		},
+		{
+			name:   "Admin Removes Member",
+			caller: admin,
+			action: removeMember,
+		},
+		{
+			name:   "Member Leaves",
+			caller: member,
+			action: removeMember,
+		},
+		{
+			name:   "Admin Deletes Organization",
+			caller: admin,
+			action: deleteOrganization,
+			want:   ErrRoleRequired,
+		},
+		{
+			name:   "Owner Deletes Organization",
+			caller: owner,
+			action: deleteOrganization,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestOrganizations(t)
+			organizationID, teamID := env.setUp(t, janeID)
+
+			adminID := env.newVerifiedUser(t, "Ada Admin", "ada@example.com")
+			env.join(t, janeID, organizationID, teamID, adminID, model.RoleMember)
+			if _, err := env.organizations.ChangeMemberRole(testContext(), janeID, organizationID, adminID, model.RoleAdmin, "jane", "127.0.0.1"); err != nil {
+				t.Fatalf("ChangeMemberRole() unexpected error = %v", err)
+			}
+			env.join(t, janeID, organizationID, teamID, johnID, model.RoleMember)
+
+			callers := map[string]int{owner: janeID, admin: adminID, member: johnID}
+			err := tt.action(env, callers[tt.caller], organizationID, teamID, johnID)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func renameOrganization(env *testOrganizations, callerID, organizationID, _, _ int) error {
+	_, err := env.organizations.Update(testContext(), callerID, organizationID, dto.OrganizationRequest{Name: "Acme Inc"})
+	return err
+}
+
+func createTeam(env *testOrganizations, callerID, organizationID, _, _ int) error {
+	_, err := env.organizations.CreateTeam(testContext(), callerID, organizationID, dto.TeamRequest{Name: "Support"}, "caller", "127.0.0.1")
+	return err
+}
+
+func promoteMember(env *testOrganizations, callerID, organizationID, _, memberID int) error {
+	_, err := env.organizations.ChangeMemberRole(testContext(), callerID, organizationID, memberID, model.RoleAdmin, "caller", "127.0.0.1")
+	return err
+}
+
+func removeMember(env *testOrganizations, callerID, organizationID, _, memberID int) error {
+	return env.organizations.RemoveMember(testContext(), callerID, organizationID, memberID, "caller", "127.0.0.1")
+}
+
+func deleteOrganization(env *testOrganizations, callerID, organizationID, _, _ int) error {
+	return env.organizations.Delete(testContext(), callerID, organizationID, "caller", "127.0.0.1")
+}
+
+func TestLastOwnerProtection(t *testing.T) {
+	env := newTestOrganizations(t)
+	organizationID, teamID := env.setUp(t, janeID)
+
+	if _, err := env.organizations.ChangeMemberRole(testContext(), janeID, organizationID, janeID, model.RoleAdmin, "jane", "127.0.0.1"); !errors.Is(err, model.ErrLastOwner) {
+		t.Errorf("ChangeMemberRole() of the last owner error = %v, want %v", err, model.ErrLastOwner)
+	}
+	if err := env.organizations.RemoveMember(testContext(), janeID, organizationID, janeID, "jane", "127.0.0.1"); !errors.Is(err, model.ErrLastOwner) {
+		t.Errorf("RemoveMember() of the last owner error = %v, want %v", err, model.ErrLastOwner)
+	}
+	if err := env.organizations.RemoveTeamMember(testContext(), janeID, organizationID, teamID, janeID, "jane", "127.0.0.1"); !errors.Is(err, model.ErrLastOwner) {
+		t.Errorf("RemoveTeamMember() of the last team owner error = %v, want %v", err, model.ErrLastOwner)
+	}
+
+	// John becomes an owner of the organization, but Jane is still the only
+	// owner of the team, so she can't leave the organization
+	env.join(t, janeID, organizationID, teamID, johnID, model.RoleMember)
+	if _, err := env.organizations.ChangeMemberRole(testContext(), janeID, organizationID, johnID, model.RoleOwner, "jane", "127.0.0.1"); err != nil {
+		t.Fatalf("ChangeMemberRole() unexpected error = %v", err)
+	}
+	if err := env.organizations.RemoveMember(testContext(), janeID, organizationID, janeID, "jane", "127.0.0.1"); !errors.Is(err, model.ErrLastOwner) {
+		t.Errorf("RemoveMember() of the last team owner error = %v, want %v", err, model.ErrLastOwner)
+	}
+
+	if _, err := env.organizations.ChangeTeamMemberRole(testContext(), johnID, organizationID, teamID, johnID, model.RoleOwner, "john", "127.0.0.1"); err != nil {
+		t.Fatalf("ChangeTeamMemberRole() unexpected error = %v", err)
+	}
+	if err := env.organizations.RemoveMember(testContext(), janeID, organizationID, janeID, "jane", "127.0.0.1"); err != nil {
+		t.Errorf("RemoveMember() with another owner unexpected error = %v", err)
+	}
+}
+
+func TestCrossOrganizationTeamAccess(t *testing.T) {
+	env := newTestOrganizations(t)
+	janeOrganizationID, janeTeamID := env.setUp(t, janeID)
+	johnOrganizationID, _ := env.setUp(t, johnID)
+
+	tests := []struct {
+		name           string
+		organizationID int
+	}{
+		{"Organization Of The Team", janeOrganizationID},
+		{"Own Organization", johnOrganizationID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var notFound *appErrors.ErrNotFound
+
+			if _, err := env.organizations.GetTeam(testContext(), johnID, tt.organizationID, janeTeamID); !errors.As(err, &notFound) {
+				t.Errorf("GetTeam() error = %v, want not found", err)
+			}
+			if _, err := env.organizations.ListTeamMembers(testContext(), johnID, tt.organizationID, janeTeamID); !errors.As(err, &notFound) {
+				t.Errorf("ListTeamMembers() error = %v, want not found", err)
+			}
+			if err := env.organizations.DeleteTeam(testContext(), johnID, tt.organizationID, janeTeamID, "john", "127.0.0.1"); !errors.As(err, &notFound) {
+				t.Errorf("DeleteTeam() error = %v, want not found", err)
+			}
+			request := dto.InvitationRequest{Email: "mallory@example.com"}
+			if _, err := env.invitations.Create(testContext(), johnID, tt.organizationID, janeTeamID, request, "john", "127.0.0.1"); !errors.As(err, &notFound) {
+				t.Errorf("Create() invitation error = %v, want not found", err)
+			}
+		})
+	}
+
+	if _, err := env.organizations.GetTeam(testContext(), janeID, janeOrganizationID, janeTeamID); err != nil {
+		t.Errorf("GetTeam() of the owner unexpected error = %v", err)
+	}
+}
@@ -0,0 +1,10 @@
+package service
+
+// PasswordHasher hashes passwords for storage and checks passwords against stored hashes.
+type PasswordHasher interface {
+	// Hash returns a salted hash of password suitable for storage
+	Hash(password string) (string, error)
+
+	// Matches reports whether password matches a hash returned by Hash
+	Matches(hash, password string) bool
+}
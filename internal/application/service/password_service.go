@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/i18n"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Password errors
+var (
+	ErrInvalidResetToken = errors.New("invalid password reset token")  // The password reset token is unknown, expired or already used
+	ErrWrongPassword     = errors.New("current password is incorrect") // The current password given to change it is wrong
+)
+
+// Register how the password errors are reported to API clients
+func init() {
+	appErrors.Register(ErrInvalidResetToken, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidResetToken,
+		Title:  constants.InvalidResetToken,
+		Detail: constants.InvalidResetDetail,
+	})
+	appErrors.Register(ErrWrongPassword, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidCredentials,
+		Title:  constants.WrongPassword,
+		Detail: constants.WrongPasswordDetail,
+	})
+}
+
+// Audit actions of the password service
+const (
+	AuditPasswordResetRequested = "password.reset_requested"
+	AuditPasswordReset          = "password.reset"
+	AuditPasswordChanged        = "password.changed"
+)
+
+// passwordResetInterval is the minimum interval between reset emails to a user
+const passwordResetInterval = time.Minute
+
+// PasswordResetPolicy configures password reset links.
+type PasswordResetPolicy struct {
+	URL string        // Page receiving the token in its "token" query parameter
+	TTL time.Duration // How long a link stays valid
+}
+
+// PasswordService handles self-service password changes and resets.
+// Reset tokens are random and only their SHA-256 hash is stored, so a leaked
+// store cannot be used to take over accounts. Tokens are single-use, and
+// setting a new password revokes every token previously issued to the user.
+type PasswordService struct {
+	userService  *domainService.UserService
+	resetRepo    repository.PasswordResetRepository
+	hasher       PasswordHasher
+	validator    *PasswordValidator
+	mailer       Mailer
+	auditService *AuditService
+	policy       PasswordResetPolicy
+}
+
+// NewPasswordService creates a new password service
+func NewPasswordService(
+	userService *domainService.UserService,
+	resetRepo repository.PasswordResetRepository,
+	hasher PasswordHasher,
+	validator *PasswordValidator,
+	mailer Mailer,
+	auditService *AuditService,
+	policy PasswordResetPolicy,
+) *PasswordService {
+	return &PasswordService{
+		userService:  userService,
+		resetRepo:    resetRepo,
+		hasher:       hasher,
+		validator:    validator,
+		mailer:       mailer,
+		auditService: auditService,
+		policy:       policy,
+	}
+}
+
+// Forgot sends a password reset link to the user with the given email address.
+// To avoid revealing which addresses have accounts, it succeeds without sending
+// anything if no user who may sign in has the address, and a failure to send the
+// email is only logged. Links are only sent to verified addresses, so an
+// unconfirmed address set by someone else cannot be used to take over the account.
+func (s *PasswordService) Forgot(ctx context.Context, email, clientIP string) error {
+	user, err := s.userService.GetUserByEmail(ctx, email)
+	if errors.Is(err, domainService.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !user.Status().CanLogIn() || !user.EmailVerified() {
+		return nil
+	}
+
+	token, err := randomToken(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	reset := model.NewPasswordReset(user.TenantID(), user.ID(), hashToken(token), now, s.policy.TTL)
+	if err := s.replaceReset(ctx, reset, now); err != nil {
+		if errors.Is(err, errResetThrottled) {
+			return nil
+		}
+		return err
+	}
+
+	link, err := url.Parse(s.policy.URL)
+	if err != nil {
+		return fmt.Errorf("invalid password reset URL: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	s.auditService.Record(ctx, AuditPasswordResetRequested, user.Email().String(), userSubject(user.ID()), clientIP, nil)
+
+	// Write the email in the user's language
+	locale := user.Locale()
+	if err := s.mailer.Send(ctx, MailMessage{
+		To:      user.Email().String(),
+		Subject: i18n.Translate(locale, constants.PasswordResetEmailSubject),
+		Body: i18n.Translate(locale, constants.PasswordResetEmailBody,
+			user.DisplayName(), link.String(), reset.ExpiresAt().UTC().Format(time.RFC1123)),
+	}); err != nil {
+		logger.Error(constants.PasswordResetSendFailed, user.ID(), err)
+	}
+	return nil
+}
+
+// Reset sets a new password with the token from a reset link.
+// The password must satisfy the password policy; a rejected password leaves the
+// token usable. Otherwise the token is consumed, and every token issued to the
+// user before the reset is revoked. Reset links work whichever tenant the
+// request was made for, as the token names the user's tenant.
+func (s *PasswordService) Reset(ctx context.Context, token, password, clientIP string) error {
+	reset, err := s.resetRepo.FindByTokenHash(ctx, hashToken(token))
+	if err != nil || reset.IsExpired(time.Now()) {
+		return ErrInvalidResetToken
+	}
+	ctx = model.WithTenant(ctx, reset.TenantID())
+
+	user, err := s.userService.GetUserByID(ctx, reset.UserID())
+	if err != nil {
+		return err
+	}
+	if err := s.validator.Validate(ctx, password, user); err != nil {
+		return err
+	}
+
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	// Consume the token before changing the password, so it can't be used twice
+	if err := s.resetRepo.Delete(ctx, reset.UserID()); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	if _, err := s.userService.ChangePassword(ctx, user.ID(), hash); err != nil {
+		return err
+	}
+
+	s.auditService.Record(ctx, AuditPasswordReset, user.Email().String(), userSubject(user.ID()), clientIP, nil)
+	return nil
+}
+
+// Change replaces a user's password after checking their current one, which
+// users without a password cannot give. The new password must satisfy the
+// password policy. Every token issued to the user before the change is revoked.
+func (s *PasswordService) Change(ctx context.Context, userID int, currentPassword, newPassword, clientIP string) error {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.HasPassword() || !s.hasher.Matches(user.PasswordHash(), currentPassword) {
+		return ErrWrongPassword
+	}
+	if err := s.validator.Validate(ctx, newPassword, user); err != nil {
+		return err
+	}
+
+	hash, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		return err
+	}
+	if _, err := s.userService.ChangePassword(ctx, user.ID(), hash); err != nil {
+		return err
+	}
+
+	s.auditService.Record(ctx, AuditPasswordChanged, user.Email().String(), userSubject(user.ID()), clientIP, nil)
+	return nil
+}
+
+// errResetThrottled is returned by replaceReset when a reset was requested too recently
+var errResetThrottled = errors.New("password reset requested too recently")
+
+// replaceReset stores a reset in place of the user's previous one, unless that
+// one was requested less than passwordResetInterval ago
+func (s *PasswordService) replaceReset(ctx context.Context, reset *model.PasswordReset, now time.Time) error {
+	if previous, err := s.resetRepo.FindByUserID(ctx, reset.UserID()); err == nil &&
+		!previous.IsExpired(now) && now.Before(previous.CreatedAt().Add(passwordResetInterval)) {
+		return errResetThrottled
+	}
+
+	if err := s.resetRepo.Save(ctx, reset); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	return nil
+}
+
+// userSubject returns the audit subject identifying a user
+func userSubject(id int) string {
+	return "user:" + strconv.Itoa(id)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// newTestPasswordService returns a password service whose reset links last ttl
+func newTestPasswordService(env *testEnv, ttl time.Duration) *PasswordService {
+	validator := NewPasswordValidator(model.PasswordPolicy{MinLength: 8, MaxLength: 64}, nil)
+	return NewPasswordService(env.users, inmemory.NewInMemoryPasswordResetRepository(), env.hasher, validator, env.mailer, env.audit,
+		PasswordResetPolicy{URL: "https://app.example.com/reset-password", TTL: ttl})
+}
+
+func TestForgotWithoutSending(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(t *testing.T, env *testEnv)
+		email string
+	}{
+		{
+			name:  "Unknown Address",
+			email: "nobody@example.com",
+		},
+		{
+			name:  "User Who Cannot Sign In",
+			email: "bob@example.com",
+		},
+		{
+			name: "Unverified Address",
+			setup: func(t *testing.T, env *testEnv) {
+				// Changing the address resets its verification, while the user stays active
+				if _, err := env.users.UpdateUser(testContext(), janeID, domainService.UserData{Name: "Jane Smith", Email: "jane@attacker.example", Age: 28}); err != nil {
+					t.Fatalf("UpdateUser() unexpected error = %v", err)
+				}
+			},
+			email: "jane@attacker.example",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			service := newTestPasswordService(env, time.Hour)
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			if err := service.Forgot(testContext(), tt.email, "127.0.0.1"); err != nil {
+				t.Errorf("Forgot() error = %v, want nil", err)
+			}
+			if got := env.mailer.sent(); got != 0 {
+				t.Errorf("Forgot() sent %d messages, want none", got)
+			}
+		})
+	}
+}
+
+func TestForgotMailerFailure(t *testing.T) {
+	env := newTestEnv(t)
+	env.mailer.err = errors.New("smtp unavailable")
+	service := newTestPasswordService(env, time.Hour)
+
+	if err := service.Forgot(testContext(), "john@example.com", "127.0.0.1"); err != nil {
+		t.Errorf("Forgot() error = %v, want nil so the account is not revealed", err)
+	}
+}
+
+func TestForgotThrottled(t *testing.T) {
+	env := newTestEnv(t)
+	service := newTestPasswordService(env, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if err := service.Forgot(testContext(), "john@example.com", "127.0.0.1"); err != nil {
+			t.Fatalf("Forgot() unexpected error = %v", err)
+		}
+	}
+	if got := env.mailer.sent(); got != 1 {
+		t.Errorf("Forgot() sent %d messages, want 1 within the resend interval", got)
+	}
+}
+
+func TestResetSingleUse(t *testing.T) {
+	ctx := testContext()
+	env := newTestEnv(t)
+	service := newTestPasswordService(env, time.Hour)
+
+	if err := service.Forgot(ctx, "john@example.com", "127.0.0.1"); err != nil {
+		t.Fatalf("Forgot() unexpected error = %v", err)
+	}
+	token := env.mailer.lastToken(t)
+
+	if err := service.Reset(ctx, token, "first new password", "127.0.0.1"); err != nil {
+		t.Fatalf("Reset() unexpected error = %v", err)
+	}
+	if err := service.Reset(ctx, token, "second new password", "127.0.0.1"); !errors.Is(err, ErrInvalidResetToken) {
+		t.Errorf("Reset() again error = %v, want %v", err, ErrInvalidResetToken)
+	}
+}
+
+func TestResetExpired(t *testing.T) {
+	ctx := testContext()
+	env := newTestEnv(t)
+	service := newTestPasswordService(env, 10*time.Millisecond)
+
+	if err := service.Forgot(ctx, "john@example.com", "127.0.0.1"); err != nil {
+		t.Fatalf("Forgot() unexpected error = %v", err)
+	}
+	token := env.mailer.lastToken(t)
+	time.Sleep(20 * time.Millisecond)
+
+	if err := service.Reset(ctx, token, "a new password", "127.0.0.1"); !errors.Is(err, ErrInvalidResetToken) {
+		t.Errorf("Reset() expired link error = %v, want %v", err, ErrInvalidResetToken)
+	}
+}
+
+func TestResetRevokesEarlierTokens(t *testing.T) {
+	ctx := testContext()
+	env := newTestEnv(t)
+	service := newTestPasswordService(env, time.Hour)
+
+	// Token times have a one second resolution
+	claims := &Claims{
+		TenantID:         model.DefaultTenantID,
+		UserID:           johnID,
+		RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(time.Now().Add(-2 * time.Second))},
+	}
+	if err := env.auth.CheckToken(ctx, claims); err != nil {
+		t.Fatalf("CheckToken() before reset unexpected error = %v", err)
+	}
+
+	if err := service.Forgot(ctx, "john@example.com", "127.0.0.1"); err != nil {
+		t.Fatalf("Forgot() unexpected error = %v", err)
+	}
+	if err := service.Reset(ctx, env.mailer.lastToken(t), "a new password", "127.0.0.1"); err != nil {
+		t.Fatalf("Reset() unexpected error = %v", err)
+	}
+
+	if err := env.auth.CheckToken(ctx, claims); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("CheckToken() after reset error = %v, want %v", err, ErrTokenRevoked)
+	}
+}
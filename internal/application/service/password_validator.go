@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/i18n"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"strconv"
+)
+
+// BreachedPasswordChecker reports whether a password is known from data breaches.
+type BreachedPasswordChecker interface {
+	// IsBreached reports whether password appears in a breached-password list
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+// passwordRuleMessages maps each password rule to its field message
+var passwordRuleMessages = map[string]string{
+	model.PasswordRuleMinLength:    constants.FieldMinLength,
+	model.PasswordRuleMaxLength:    constants.FieldMaxLength,
+	model.PasswordRuleMaxBytes:     constants.FieldMaxBytes,
+	model.PasswordRuleCharClasses:  constants.FieldPasswordCharClasses,
+	model.PasswordRulePersonalInfo: constants.FieldPasswordPersonalInfo,
+	model.PasswordRuleBreached:     constants.FieldPasswordBreached,
+}
+
+// PasswordValidator checks new passwords against the password policy and,
+// if a checker is configured, against a list of breached passwords.
+type PasswordValidator struct {
+	policy   model.PasswordPolicy
+	breached BreachedPasswordChecker
+}
+
+// NewPasswordValidator creates a password validator.
+// breached may be nil to skip the breached-password check.
+func NewPasswordValidator(policy model.PasswordPolicy, breached BreachedPasswordChecker) *PasswordValidator {
+	return &PasswordValidator{
+		policy:   policy,
+		breached: breached,
+	}
+}
+
+// Validate checks password as the new password of user, which may be nil.
+// Violations are returned as an *appErrors.ErrValidation on the "password" field,
+// with messages in the locale of ctx, so they reach clients like request validation errors.
+func (v *PasswordValidator) Validate(ctx context.Context, password string, user *model.User) error {
+	violations := v.policy.Check(password, user)
+
+	// A breach check failure shouldn't lock users out of setting a password, so it's only logged
+	if v.breached != nil {
+		breached, err := v.breached.IsBreached(ctx, password)
+		if err != nil {
+			logger.Error(constants.BreachCheckFailed, err)
+		}
+		if breached {
+			violations = append(violations, model.PasswordViolation{Rule: model.PasswordRuleBreached})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	locale := i18n.LocaleFromContext(ctx)
+	validationErr := &appErrors.ErrValidation{}
+	for _, violation := range violations {
+		args := []any{"password"}
+		if violation.Limit > 0 {
+			args = append(args, strconv.Itoa(violation.Limit))
+		}
+		validationErr.Fields = append(validationErr.Fields, appErrors.FieldViolation{
+			Field:   "password",
+			Code:    violation.Rule,
+			Message: i18n.Translate(locale, passwordRuleMessages[violation.Rule], args...),
+		})
+	}
+	return validationErr
+}
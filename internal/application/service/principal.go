@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"slices"
+)
+
+// ErrScopeNotHeld is returned when new credentials would be granted a scope the caller creating them does not hold
+var ErrScopeNotHeld = errors.New("scope not held by the caller")
+
+// Register how the principal errors are reported to API clients
+func init() {
+	appErrors.Register(ErrScopeNotHeld, appErrors.Mapping{
+		Status: http.StatusForbidden,
+		Code:   appErrors.CodeInsufficientScope,
+		Title:  constants.InsufficientScope,
+		Detail: constants.ScopeNotHeldDetail,
+	})
+}
+
+// Principal identifies the caller of a request: a signed-in user, an API key
+// or an OAuth client. Authentication middleware stores it in the request context.
+type Principal struct {
+	Subject   string   // "sub" claim of a token, or the API key's prefix
+	TenantID  string   // Tenant the credentials were issued in
+	UserID    int      // Signed-in user, 0 for API keys and OAuth clients
+	Username  string   // User's username, API key's prefix or OAuth client's ID
+	Admin     bool     // Whether the caller may use admin-only routes
+	AMR       []string // Authentication methods of a user token, see the AMR constants
+	Scopes    []string // Granted scopes, see model.Scopes
+	APIKeyID  int      // API key used, 0 if none
+	ClientID  string   // OAuth client the token was issued to, empty if none
+	TokenID   string   // "jti" claim of the token, empty for API keys
+	SessionID int      // Session of a signed-in user, 0 if none
+	Actor     *Actor   // Administrator impersonating the user, nil if none
+}
+
+// IsUser reports whether the principal is a signed-in user
+func (p *Principal) IsUser() bool {
+	return p.UserID != 0
+}
+
+// IsImpersonated reports whether an administrator is acting as the user
+func (p *Principal) IsImpersonated() bool {
+	return p.Actor != nil
+}
+
+// HasScope reports whether the principal was granted scope
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// HasAMR reports whether the principal authenticated with method
+func (p *Principal) HasAMR(method string) bool {
+	return slices.Contains(p.AMR, method)
+}
+
+// principalKey is the context key of the request principal
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the caller of the request.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller of the request carried by ctx,
+// or false if the request was not authenticated.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// checkHeldScopes verifies that the caller of ctx holds every API scope among
+// scopes, so the API keys and OAuth clients it creates never get more access than
+// the credentials used to create them. Scopes only OAuth clients can hold, such as
+// model.ScopeTokensIntrospect, are left to the admin-only routes creating them.
+func checkHeldScopes(ctx context.Context, scopes []string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	for _, scope := range scopes {
+		if slices.Contains(model.Scopes, scope) && (!ok || !principal.HasScope(scope)) {
+			return fmt.Errorf("%w: %q", ErrScopeNotHeld, scope)
+		}
+	}
+	return nil
+}
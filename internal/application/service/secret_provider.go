@@ -0,0 +1,14 @@
+package service
+
+import "context"
+
+// JWTSecretName is the name of the JWT signing key in the secret provider
+const JWTSecretName = "jwt_secret"
+
+// SecretProvider supplies secrets such as signing keys and database passwords at runtime.
+// Secrets are fetched on use rather than copied at startup, so implementations
+// can rotate them without a restart.
+type SecretProvider interface {
+	// GetSecret returns the current value of the named secret
+	GetSecret(ctx context.Context, name string) (string, error)
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Session errors
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")    // The refresh token is unknown, was already used, or its session ended
+	ErrSessionRevoked      = errors.New("session has been revoked") // The access token's session was revoked or has ended
+)
+
+// Register how the session errors are reported to API clients
+func init() {
+	appErrors.Register(ErrInvalidRefreshToken, appErrors.Mapping{
+		Status: http.StatusUnauthorized,
+		Code:   appErrors.CodeInvalidRefreshToken,
+		Title:  constants.UnauthorizedAccess,
+		Detail: constants.InvalidRefreshTokenDetail,
+	})
+	appErrors.Register(ErrSessionRevoked, appErrors.Mapping{
+		Status: http.StatusUnauthorized,
+		Code:   appErrors.CodeInvalidToken,
+		Title:  constants.UnauthorizedAccess,
+		Detail: constants.SessionRevokedDetail,
+	})
+}
+
+// Audit actions of the session service
+const (
+	AuditSessionRevoked = "session.revoked"
+)
+
+// sessionTouchInterval is how often the last-seen time of a session is updated,
+// so busy sessions don't cause a write on every request
+const sessionTouchInterval = time.Minute
+
+// SessionService tracks the devices users are signed in on. Each sign-in
+// creates a session holding a refresh token, which is replaced on every use;
+// only SHA-256 hashes of refresh tokens are stored.
+type SessionService struct {
+	sessionRepo  repository.SessionRepository
+	userService  *domainService.UserService
+	auditService *AuditService
+	ttl          time.Duration
+
+	// mu serializes updates of stored sessions, so that recording a use cannot
+	// undo a concurrent refresh or revocation of the same session
+	mu sync.Mutex
+}
+
+// NewSessionService creates a new session service whose sessions last ttl
+func NewSessionService(
+	sessionRepo repository.SessionRepository,
+	userService *domainService.UserService,
+	auditService *AuditService,
+	ttl time.Duration,
+) *SessionService {
+	return &SessionService{
+		sessionRepo:  sessionRepo,
+		userService:  userService,
+		auditService: auditService,
+		ttl:          ttl,
+	}
+}
+
+// Create starts a session in the tenant of ctx for a sign-in from the given
+// client and returns it with its refresh token, which is shown only once.
+func (s *SessionService) Create(ctx context.Context, grant model.SessionGrant, clientIP, userAgent string) (*model.Session, string, error) {
+	tenantID, err := model.TenantFromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	grant.TenantID = tenantID
+
+	refreshToken, err := randomToken(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+
+	session := model.NewSession(grant, hashToken(refreshToken), clientIP, userAgent, time.Now(), s.ttl)
+	if err := s.sessionRepo.Save(ctx, session); err != nil {
+		return nil, "", fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	return session, refreshToken, nil
+}
+
+// Rotate exchanges a refresh token for a new one, returning its active session.
+// Each refresh token is used once; it returns ErrInvalidRefreshToken for unknown,
+// used and revoked tokens, for sessions that have ended and for sessions of
+// another tenant than ctx.
+func (s *SessionService) Rotate(ctx context.Context, refreshToken, clientIP, userAgent string) (*model.Session, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.sessionRepo.FindByRefreshTokenHash(ctx, hashToken(refreshToken))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, "", ctxErr
+		}
+		return nil, "", ErrInvalidRefreshToken
+	}
+
+	now := time.Now()
+	if !session.IsActive(now) || CheckTenant(ctx, session.TenantID()) != nil {
+		return nil, "", ErrInvalidRefreshToken
+	}
+
+	newToken, err := randomToken(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	session.RotateRefreshToken(hashToken(newToken), now, clientIP, userAgent)
+	if err := s.sessionRepo.Save(ctx, session); err != nil {
+		return nil, "", fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+	return session, newToken, nil
+}
+
+// Check returns ErrSessionRevoked unless the session is active
+func (s *SessionService) Check(ctx context.Context, sessionID int) error {
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return ErrSessionRevoked
+	}
+	if !session.IsActive(time.Now()) {
+		return ErrSessionRevoked
+	}
+	return nil
+}
+
+// Touch records that the session was used from the given client. Failures
+// are logged rather than returned, as they don't affect the request.
+func (s *SessionService) Touch(ctx context.Context, sessionID int, clientIP, userAgent string) {
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(session.LastSeenAt()) < sessionTouchInterval && session.IP() == clientIP {
+		return
+	}
+
+	// Read the session again, as it may have changed before the lock was taken
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, err = s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil || !session.IsActive(now) {
+		return
+	}
+	session.Touch(now, clientIP, userAgent)
+	if err := s.sessionRepo.Save(ctx, session); err != nil {
+		logger.Error(constants.SessionTouchFailed, sessionID, err)
+	}
+}
+
+// List returns the active sessions of a user, flagging currentID as the
+// session of the request. Sessions signed in before the user's last password
+// change are left out, as they can no longer be used.
+func (s *SessionService) List(ctx context.Context, userID, currentID int) ([]dto.SessionResponse, error) {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.sessionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	now := time.Now()
+	responses := make([]dto.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		if !session.IsActive(now) || session.CreatedAt().Unix() < user.PasswordChangedAt().Unix() {
+			continue
+		}
+		responses = append(responses, dto.ToSessionResponse(session, session.ID() == currentID))
+	}
+	return responses, nil
+}
+
+// Revoke ends a session of a user, signing the device out: its refresh token
+// stops working and its access tokens are rejected.
+func (s *SessionService) Revoke(ctx context.Context, userID, sessionID int, actor, clientIP string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil || session.UserID() != userID || !session.IsActive(time.Now()) || CheckTenant(ctx, session.TenantID()) != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return &appErrors.ErrNotFound{Resource: "session", ID: sessionID}
+	}
+
+	session.Revoke(time.Now())
+	if err := s.sessionRepo.Save(ctx, session); err != nil {
+		return fmt.Errorf("%w: %w", domainService.ErrRepositoryError, err)
+	}
+
+	s.auditService.Record(ctx, AuditSessionRevoked, actor, userSubject(userID), clientIP, map[string]string{
+		"session_id": strconv.Itoa(sessionID),
+	})
+	return nil
+}
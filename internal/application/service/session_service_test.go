@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+)
+
+// startSession signs a sample user in, returning the session and its refresh token
+func startSession(t *testing.T, env *testEnv, userID int) (*model.Session, string) {
+	t.Helper()
+
+	session, refreshToken, err := env.sessions.Create(testContext(), model.SessionGrant{UserID: userID, Scopes: model.Scopes}, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+	return session, refreshToken
+}
+
+func TestRotateRejectsReusedToken(t *testing.T) {
+	env := newTestEnv(t)
+	_, refreshToken := startSession(t, env, janeID)
+
+	_, rotated, err := env.sessions.Rotate(testContext(), refreshToken, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("Rotate() unexpected error = %v", err)
+	}
+	if _, _, err := env.sessions.Rotate(testContext(), refreshToken, "127.0.0.1", "test"); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Rotate() reused token error = %v, want %v", err, ErrInvalidRefreshToken)
+	}
+
+	// Concurrent requests with the same token rotate it once
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		accepted int
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := env.sessions.Rotate(testContext(), rotated, "127.0.0.1", "test"); err == nil {
+				mu.Lock()
+				accepted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if accepted != 1 {
+		t.Errorf("Rotate() accepted the same token %d times, want once", accepted)
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		userID   int
+		wantErr  bool
+		wantLive bool
+	}{
+		{
+			name:   "Own Session",
+			ctx:    testContext(),
+			userID: janeID,
+		},
+		{
+			name:     "Session Of Another User",
+			ctx:      testContext(),
+			userID:   johnID,
+			wantErr:  true,
+			wantLive: true,
+		},
+		{
+			name:     "Session Of Another Tenant",
+			ctx:      model.WithTenant(context.Background(), "acme"),
+			userID:   janeID,
+			wantErr:  true,
+			wantLive: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			session, _ := startSession(t, env, janeID)
+
+			err := env.sessions.Revoke(tt.ctx, tt.userID, session.ID(), "jane@example.com", "127.0.0.1")
+			var notFound *appErrors.ErrNotFound
+			if tt.wantErr != errors.As(err, &notFound) || (!tt.wantErr && err != nil) {
+				t.Fatalf("Revoke() error = %v, want not found %v", err, tt.wantErr)
+			}
+
+			live := env.sessions.Check(testContext(), session.ID()) == nil
+			if live != tt.wantLive {
+				t.Errorf("session active = %v, want %v", live, tt.wantLive)
+			}
+		})
+	}
+}
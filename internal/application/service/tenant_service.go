@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+)
+
+// Tenant errors
+var (
+	ErrTenantNotFound = errors.New("tenant not found")                     // The request names a tenant that does not exist
+	ErrTenantMismatch = errors.New("credentials belong to another tenant") // The caller's credentials were issued in another tenant
+)
+
+// Register how the tenant errors are reported to API clients
+func init() {
+	appErrors.Register(ErrTenantNotFound, appErrors.Mapping{
+		Status: http.StatusNotFound,
+		Code:   appErrors.CodeTenantNotFound,
+		Title:  constants.TenantNotFound,
+		Detail: constants.TenantNotFoundDetail,
+	})
+	appErrors.Register(ErrTenantMismatch, appErrors.Mapping{
+		Status: http.StatusForbidden,
+		Code:   appErrors.CodeTenantMismatch,
+		Title:  constants.TenantMismatch,
+		Detail: constants.TenantMismatchDetail,
+	})
+}
+
+// TenantService looks up the tenants requests are made for.
+type TenantService struct {
+	tenantRepo repository.TenantRepository
+}
+
+// NewTenantService creates a new tenant service
+func NewTenantService(tenantRepo repository.TenantRepository) *TenantService {
+	return &TenantService{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// Get returns the tenant with the given ID, or ErrTenantNotFound.
+func (s *TenantService) Get(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	tenant, err := s.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, ErrTenantNotFound
+	}
+	return tenant, nil
+}
+
+// CheckTenant returns ErrTenantMismatch unless ctx is scoped to the tenant
+// credentials were issued in.
+func CheckTenant(ctx context.Context, tenantID string) error {
+	current, err := model.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if current != tenantID {
+		return ErrTenantMismatch
+	}
+	return nil
+}
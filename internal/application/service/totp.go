@@ -0,0 +1,18 @@
+package service
+
+import "time"
+
+// TOTP generates and verifies time-based one-time passwords (RFC 6238)
+// for authenticator apps.
+type TOTP interface {
+	// NewSecret returns a new random secret, encoded in base32
+	NewSecret() (string, error)
+
+	// ProvisioningURI returns the otpauth:// URI an authenticator app imports,
+	// usually from a QR code, to generate codes for secret
+	ProvisioningURI(secret, issuer, account string) string
+
+	// Verify checks a code for secret at the given time and returns the time
+	// step it belongs to, allowing for some clock drift
+	Verify(secret, code string, at time.Time) (counter int64, ok bool)
+}
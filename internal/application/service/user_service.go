@@ -2,20 +2,67 @@ package service
 
 import (
 	"context"
+	"errors"
 	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
 	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
+)
+
+// User errors
+var (
+	ErrNotSignedIn           = errors.New("request was not made by a signed-in user")              // The current-user operations were not called by a signed-in user, e.g. with an API key
+	ErrEmailChangeNotAllowed = errors.New("only administrators can change a user's email address") // A user's email address was changed by someone other than an administrator
+	ErrOwnAccountOnly        = errors.New("users can only change or delete their own account")     // A signed-in user other than an administrator changed or deleted another user
+)
+
+// Register how the user errors are reported to API clients
+func init() {
+	appErrors.Register(ErrNotSignedIn, appErrors.Mapping{
+		Status: http.StatusForbidden,
+		Code:   appErrors.CodeForbidden,
+		Title:  constants.ForbiddenAction,
+		Detail: constants.SignedInUserRequiredDetail,
+	})
+	appErrors.Register(ErrEmailChangeNotAllowed, appErrors.Mapping{
+		Status: http.StatusForbidden,
+		Code:   appErrors.CodeForbidden,
+		Title:  constants.ForbiddenAction,
+		Detail: constants.EmailChangeAdminOnlyDetail,
+	})
+	appErrors.Register(ErrOwnAccountOnly, appErrors.Mapping{
+		Status: http.StatusForbidden,
+		Code:   appErrors.CodeForbidden,
+		Title:  constants.ForbiddenAction,
+		Detail: constants.OwnAccountOnlyDetail,
+	})
+}
+
+// Audit actions of the user application service
+const (
+	AuditAccountDeleted = "account.deleted"
 )
 
 // UserApplicationService orchestrates the application flow for user operations.
 // It coordinates domain logic and provides a use-case focused API for controllers.
 type UserApplicationService struct {
 	userDomainService *domainService.UserService
+	passwordService   *PasswordService
+	auditService      *AuditService
 }
 
 // NewUserApplicationService creates a new user application service instance.
-func NewUserApplicationService(userDomainService *domainService.UserService) *UserApplicationService {
+func NewUserApplicationService(
+	userDomainService *domainService.UserService,
+	passwordService *PasswordService,
+	auditService *AuditService,
+) *UserApplicationService {
 	return &UserApplicationService{
 		userDomainService: userDomainService,
+		passwordService:   passwordService,
+		auditService:      auditService,
 	}
 }
 
@@ -43,7 +90,7 @@ func (s *UserApplicationService) GetAllUsers(ctx context.Context) ([]dto.UserRes
 // CreateUser processes a user creation request.
 func (s *UserApplicationService) CreateUser(ctx context.Context, request dto.UserRequest) (*dto.UserResponse, error) {
 	// Delegate to domain service for core business logic
-	user, err := s.userDomainService.CreateUser(ctx, request.Name, request.Email, request.Age)
+	user, err := s.userDomainService.CreateUser(ctx, toUserData(request))
 	if err != nil {
 		return nil, err
 	}
@@ -54,9 +101,27 @@ func (s *UserApplicationService) CreateUser(ctx context.Context, request dto.Use
 }
 
 // UpdateUser processes a user update request.
+// Signed-in users other than administrators may only update themselves, see
+// userManager. Only administrators may change the email address, as it is what
+// password reset links are sent to; users change their own through UpdateCurrentUser.
 func (s *UserApplicationService) UpdateUser(ctx context.Context, id int, request dto.UserRequest) (*dto.UserResponse, error) {
+	principal, err := userManager(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !principal.Admin {
+		user, err := s.userDomainService.GetUserByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if email, err := model.NewEmail(request.Email); err == nil && !user.Email().Equals(email) {
+			return nil, ErrEmailChangeNotAllowed
+		}
+	}
+
 	// Delegate to domain service for core business logic
-	user, err := s.userDomainService.UpdateUser(ctx, id, request.Name, request.Email, request.Age)
+	user, err := s.userDomainService.UpdateUser(ctx, id, toUserData(request))
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +132,194 @@ func (s *UserApplicationService) UpdateUser(ctx context.Context, id int, request
 }
 
 // DeleteUser processes a user deletion request.
+// Signed-in users other than administrators may only delete themselves, see
+// userManager, and administrators impersonating them cannot.
 func (s *UserApplicationService) DeleteUser(ctx context.Context, id int) error {
+	principal, err := userManager(ctx, id)
+	if err != nil {
+		return err
+	}
+	if principal.IsImpersonated() {
+		return ErrImpersonationRestricted
+	}
+
 	return s.userDomainService.DeleteUser(ctx, id)
 }
+
+// TransitionUser processes a user lifecycle transition, such as a suspension, performed by actor.
+func (s *UserApplicationService) TransitionUser(ctx context.Context, id int, transition model.UserTransition, actor, reason string) (*dto.UserResponse, error) {
+	user, err := s.userDomainService.TransitionUser(ctx, id, transition, actor, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToUserResponse(user)
+	return &response, nil
+}
+
+// GetCurrentUser retrieves the signed-in user making the request.
+func (s *UserApplicationService) GetCurrentUser(ctx context.Context) (*dto.UserResponse, error) {
+	principal, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetUserByID(ctx, principal.UserID)
+}
+
+// UpdateCurrentUser applies a partial update to the profile of the signed-in
+// user making the request. Fields omitted from the request keep their values.
+// Administrators impersonating the user cannot change the email address.
+func (s *UserApplicationService) UpdateCurrentUser(ctx context.Context, request dto.UserPatchRequest) (*dto.UserResponse, error) {
+	caller := currentUser
+	if request.Email != nil {
+		caller = accountOwner
+	}
+	principal, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userDomainService.GetUserByID(ctx, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := userData(user)
+	patchUserData(&data, request)
+	user, err = s.userDomainService.UpdateUser(ctx, user.ID(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToUserResponse(user)
+	return &response, nil
+}
+
+// ChangeCurrentUserPassword changes the password of the signed-in user making
+// the request, after checking their current password. Administrators
+// impersonating the user cannot change it.
+func (s *UserApplicationService) ChangeCurrentUserPassword(ctx context.Context, request dto.PasswordChangeRequest, clientIP string) error {
+	principal, err := accountOwner(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.passwordService.Change(ctx, principal.UserID, request.CurrentPassword, request.NewPassword, clientIP)
+}
+
+// DeleteCurrentUser deletes the account of the signed-in user making the
+// request. Administrators impersonating the user cannot delete it.
+func (s *UserApplicationService) DeleteCurrentUser(ctx context.Context, clientIP string) error {
+	principal, err := accountOwner(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userDomainService.DeleteUser(ctx, principal.UserID); err != nil {
+		return err
+	}
+
+	s.auditService.Record(ctx, AuditAccountDeleted, principal.Username, userSubject(principal.UserID), clientIP, nil)
+	return nil
+}
+
+// currentUser returns the signed-in user making the request, or ErrNotSignedIn
+// for API keys, OAuth clients and unauthenticated requests
+func currentUser(ctx context.Context) (*Principal, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || !principal.IsUser() {
+		return nil, ErrNotSignedIn
+	}
+	return principal, nil
+}
+
+// accountOwner returns the signed-in user making the request, like currentUser,
+// and ErrImpersonationRestricted if an administrator is acting as them
+func accountOwner(ctx context.Context) (*Principal, error) {
+	principal, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.IsImpersonated() {
+		return nil, ErrImpersonationRestricted
+	}
+	return principal, nil
+}
+
+// userManager returns the caller of a request changing or deleting the user with
+// the given ID. Administrators, and API keys and OAuth clients granted the write
+// scope, may manage every user of the tenant; other signed-in users only themselves,
+// as every login is granted the write scope by default.
+func userManager(ctx context.Context, id int) (*Principal, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrOwnAccountOnly
+	}
+	if principal.IsUser() && !principal.Admin && principal.UserID != id {
+		return nil, ErrOwnAccountOnly
+	}
+	return principal, nil
+}
+
+// userData returns the current attributes of a user
+func userData(user *model.User) domainService.UserData {
+	// An unset display name defaults to the name; keep it unset so it follows name changes
+	displayName := user.DisplayName()
+	if displayName == user.Name() {
+		displayName = ""
+	}
+
+	return domainService.UserData{
+		Name:        user.Name(),
+		DisplayName: displayName,
+		Email:       user.Email().String(),
+		PhoneNumber: user.PhoneNumber().String(),
+		Age:         user.Age().Int(),
+		Locale:      user.Locale(),
+		Timezone:    user.Timezone(),
+		Metadata:    user.Metadata(),
+	}
+}
+
+// patchUserData overwrites the attributes present in a partial update request
+func patchUserData(data *domainService.UserData, request dto.UserPatchRequest) {
+	if request.Name != nil {
+		data.Name = *request.Name
+	}
+	if request.DisplayName != nil {
+		data.DisplayName = *request.DisplayName
+	}
+	if request.Email != nil {
+		data.Email = *request.Email
+	}
+	if request.PhoneNumber != nil {
+		data.PhoneNumber = *request.PhoneNumber
+	}
+	if request.Age != nil {
+		data.Age = *request.Age
+	}
+	if request.Locale != nil {
+		data.Locale = *request.Locale
+	}
+	if request.Timezone != nil {
+		data.Timezone = *request.Timezone
+	}
+	if request.Metadata != nil {
+		data.Metadata = request.Metadata
+	}
+}
+
+// toUserData converts a user request to the attributes expected by the domain.
+func toUserData(request dto.UserRequest) domainService.UserData {
+	return domainService.UserData{
+		Name:        request.Name,
+		DisplayName: request.DisplayName,
+		Email:       request.Email,
+		PhoneNumber: request.PhoneNumber,
+		Age:         request.Age,
+		Locale:      request.Locale,
+		Timezone:    request.Timezone,
+		Metadata:    request.Metadata,
+	}
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+)
+
+func TestUpdateUserEmailChange(t *testing.T) {
+	user := &Principal{UserID: bobID, Username: "bob@example.com", Scopes: []string{"users:write"}}
+	apiKey := &Principal{Username: "key_abc", APIKeyID: 1, Scopes: []string{"users:write"}}
+	admin := &Principal{UserID: adminID, Username: "admin", Admin: true}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		email     string
+		wantErr   error
+	}{
+		{
+			name:      "User Keeps Email",
+			principal: user,
+			email:     "bob@example.com",
+		},
+		{
+			name:      "User Changes Email",
+			principal: user,
+			email:     "bob@attacker.example",
+			wantErr:   ErrEmailChangeNotAllowed,
+		},
+		{
+			name:      "API Key Changes Email",
+			principal: apiKey,
+			email:     "bob@attacker.example",
+			wantErr:   ErrEmailChangeNotAllowed,
+		},
+		{
+			name:      "Admin Changes Email",
+			principal: admin,
+			email:     "bob@example.org",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			service := NewUserApplicationService(env.users, nil, env.audit)
+
+			ctx := WithPrincipal(testContext(), tt.principal)
+
+			_, err := service.UpdateUser(ctx, bobID, dto.UserRequest{Name: "Bob Johnson", Email: tt.email, Age: 45})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateUser() error = %v, want %v", err, tt.wantErr)
+			}
+
+			stored, err := env.users.GetUserByID(ctx, bobID)
+			if err != nil {
+				t.Fatalf("GetUserByID() unexpected error = %v", err)
+			}
+			want := tt.email
+			if tt.wantErr != nil {
+				want = "bob@example.com"
+			}
+			if stored.Email().String() != want {
+				t.Errorf("stored email = %s, want %s", stored.Email(), want)
+			}
+		})
+	}
+}
+
+func TestManageOtherUsers(t *testing.T) {
+	user := &Principal{UserID: janeID, Username: "jane@example.com", Scopes: []string{"users:read", "users:write"}}
+	impersonated := &Principal{UserID: janeID, Username: "jane@example.com", Scopes: []string{"users:read", "users:write"}, Actor: &Actor{Subject: "5", Username: "admin"}}
+	apiKey := &Principal{Username: "key_abc", APIKeyID: 1, Scopes: []string{"users:write"}}
+	admin := &Principal{UserID: adminID, Username: "admin", Admin: true, Scopes: []string{"users:read", "users:write"}}
+
+	// Updates keep the user's email address, which only administrators may change
+	emails := map[int]string{johnID: "john@example.com", janeID: "jane@example.com"}
+	update := func(service *UserApplicationService, ctx context.Context, id int) error {
+		_, err := service.UpdateUser(ctx, id, dto.UserRequest{Name: "Renamed", Email: emails[id], Age: 30})
+		return err
+	}
+	remove := func(service *UserApplicationService, ctx context.Context, id int) error {
+		return service.DeleteUser(ctx, id)
+	}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		operation func(service *UserApplicationService, ctx context.Context, id int) error
+		userID    int
+		wantErr   error
+	}{
+		{"User Updates Another User", user, update, johnID, ErrOwnAccountOnly},
+		{"User Deletes Another User", user, remove, johnID, ErrOwnAccountOnly},
+		{"User Updates Themselves", user, update, janeID, nil},
+		{"User Deletes Themselves", user, remove, janeID, nil},
+		{"Impersonated User Deletes Themselves", impersonated, remove, janeID, ErrImpersonationRestricted},
+		{"Admin Updates Another User", admin, update, johnID, nil},
+		{"Admin Deletes Another User", admin, remove, johnID, nil},
+		{"API Key Updates A User", apiKey, update, johnID, nil},
+		{"API Key Deletes A User", apiKey, remove, johnID, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			service := NewUserApplicationService(env.users, nil, env.audit)
+
+			ctx := WithPrincipal(testContext(), tt.principal)
+			if err := tt.operation(service, ctx, tt.userID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			// A refused change leaves the user untouched
+			if tt.wantErr != nil {
+				stored, err := env.users.GetUserByID(testContext(), tt.userID)
+				if err != nil {
+					t.Fatalf("GetUserByID() unexpected error = %v", err)
+				}
+				if stored.Name() == "Renamed" {
+					t.Errorf("user %d was updated", tt.userID)
+				}
+			}
+		})
+	}
+}
+
+func TestCurrentUserOperations(t *testing.T) {
+	user := &Principal{UserID: janeID, Username: "jane@example.com", Scopes: []string{"users:read", "users:write"}}
+	impersonated := &Principal{UserID: janeID, Username: "jane@example.com", Actor: &Actor{Subject: "5", Username: "admin"}}
+	apiKey := &Principal{Username: "key_abc", APIKeyID: 1, Scopes: []string{"users:read", "users:write"}}
+	client := &Principal{Username: "client_1", ClientID: "client_1", Scopes: []string{"users:read", "users:write"}}
+
+	name, email := "Janet Smith", "janet@example.com"
+	operations := map[string]func(ctx context.Context, service *UserApplicationService) error{
+		"Get": func(ctx context.Context, service *UserApplicationService) error {
+			_, err := service.GetCurrentUser(ctx)
+			return err
+		},
+		"Update Name": func(ctx context.Context, service *UserApplicationService) error {
+			_, err := service.UpdateCurrentUser(ctx, dto.UserPatchRequest{Name: &name})
+			return err
+		},
+		"Update Email": func(ctx context.Context, service *UserApplicationService) error {
+			_, err := service.UpdateCurrentUser(ctx, dto.UserPatchRequest{Email: &email})
+			return err
+		},
+		"Change Password": func(ctx context.Context, service *UserApplicationService) error {
+			return service.ChangeCurrentUserPassword(ctx, dto.PasswordChangeRequest{CurrentPassword: "jane's password", NewPassword: "a new password"}, "127.0.0.1")
+		},
+		"Delete": func(ctx context.Context, service *UserApplicationService) error {
+			return service.DeleteCurrentUser(ctx, "127.0.0.1")
+		},
+	}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		wantErr   map[string]error // Expected error of each operation, nil if missing
+	}{
+		{
+			name:      "User",
+			principal: user,
+		},
+		{
+			name:      "Impersonated User",
+			principal: impersonated,
+			wantErr: map[string]error{
+				"Update Email":    ErrImpersonationRestricted,
+				"Change Password": ErrImpersonationRestricted,
+				"Delete":          ErrImpersonationRestricted,
+			},
+		},
+		{
+			name:      "API Key",
+			principal: apiKey,
+			wantErr: map[string]error{
+				"Get":             ErrNotSignedIn,
+				"Update Name":     ErrNotSignedIn,
+				"Update Email":    ErrNotSignedIn,
+				"Change Password": ErrNotSignedIn,
+				"Delete":          ErrNotSignedIn,
+			},
+		},
+		{
+			name:      "OAuth Client",
+			principal: client,
+			wantErr: map[string]error{
+				"Get":             ErrNotSignedIn,
+				"Update Name":     ErrNotSignedIn,
+				"Update Email":    ErrNotSignedIn,
+				"Change Password": ErrNotSignedIn,
+				"Delete":          ErrNotSignedIn,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for operation, run := range operations {
+			t.Run(tt.name+"/"+operation, func(t *testing.T) {
+				env := newTestEnv(t)
+				env.setPassword(t, janeID, "jane's password")
+				service := NewUserApplicationService(env.users, newTestPasswordService(env, time.Hour), env.audit)
+
+				err := run(WithPrincipal(testContext(), tt.principal), service)
+				if want := tt.wantErr[operation]; !errors.Is(err, want) {
+					t.Errorf("%s error = %v, want %v", operation, err, want)
+				}
+			})
+		}
+	}
+}
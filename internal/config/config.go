@@ -1,30 +1,112 @@
 package config
 
 import (
+	"errors"
+	"flag"
 	"log"
-
-	"github.com/caarlos0/env/v6"
+	"os"
 )
 
-// Config represents the application configuration loaded from environment variables.
-// This centralized structure makes configuration management easier and more consistent.
+// Config represents the application configuration.
+// Values are layered: defaults, then an optional YAML/TOML file, then environment
+// variables, then command-line flags. See Loader for details.
 type Config struct {
-	ServerAddress      string `env:"SERVER_ADDRESS" envDefault:":8080"`    // HTTP server listening address and port
-	Environment        string `env:"ENVIRONMENT" envDefault:"development"` // Runtime environment (development, staging, production)
-	LogLevel           string `env:"LOG_LEVEL" envDefault:"info"`          // Logging verbosity level
-	JWTSecret          string `env:"JWT_SECRET" envDefault:"mysecretkey"`  // Secret key for JWT token signing and verification
-	JWTExpirationHours int    `env:"JWT_EXPIRATION_HOURS" envDefault:"24"` // JWT token expiration time in hours
+	ServerAddress      string `env:"SERVER_ADDRESS" envDefault:":8080"`                 // HTTP server listening address and port
+	Environment        string `env:"ENVIRONMENT" envDefault:"development"`              // Runtime environment (development, staging, production)
+	LogLevel           string `env:"LOG_LEVEL" envDefault:"info"`                       // Logging verbosity level
+	JWTSecret          string `env:"JWT_SECRET" envDefault:"mysecretkey" secret:"true"` // Secret key for JWT token signing and verification
+	JWTExpirationHours int    `env:"JWT_EXPIRATION_HOURS" envDefault:"24"`              // JWT token expiration time in hours
+	JWTIssuer          string `env:"JWT_ISSUER" envDefault:"example-golang-api"`        // "iss" claim of issued tokens, required on incoming tokens
+	JWTAudience        string `env:"JWT_AUDIENCE" envDefault:"example-golang-api"`      // "aud" claim of issued tokens, required on incoming tokens
+	ShutdownTimeoutSec int    `env:"SHUTDOWN_TIMEOUT_SECONDS" envDefault:"15"`          // Grace period for in-flight requests on shutdown
+	RequestTimeoutSec  int    `env:"REQUEST_TIMEOUT_SECONDS" envDefault:"10"`           // Default deadline for handling a request
+
+	// Cross-Origin Resource Sharing
+	CORSAllowOrigins     []string `env:"CORS_ALLOW_ORIGINS" envDefault:"http://localhost:3000,http://localhost:8080,http://127.0.0.1:3000,http://127.0.0.1:8080" envSeparator:","` // Origins allowed to call the API
+	CORSAllowCredentials bool     `env:"CORS_ALLOW_CREDENTIALS" envDefault:"true"`                                                                                                 // Whether cookies and auth headers may be sent cross-origin
+
+	// Rate limit policies, expressed as maximum requests per window
+	RateLimitWindowSec int `env:"RATE_LIMIT_WINDOW_SECONDS" envDefault:"60"` // Length of the rate limit window in seconds
+	RateLimitGlobalMax int `env:"RATE_LIMIT_GLOBAL_MAX" envDefault:"600"`    // Requests per IP across the whole API
+	RateLimitLoginMax  int `env:"RATE_LIMIT_LOGIN_MAX" envDefault:"10"`      // Login attempts per client
+	RateLimitReadMax   int `env:"RATE_LIMIT_READ_MAX" envDefault:"300"`      // Read requests per client
+	RateLimitWriteMax  int `env:"RATE_LIMIT_WRITE_MAX" envDefault:"60"`      // Write requests per client
+
+	// Login brute-force protection
+	LoginMaxFailures      int `env:"LOGIN_MAX_FAILURES" envDefault:"5"`            // Failures per account before lockout
+	LoginIPMaxFailures    int `env:"LOGIN_IP_MAX_FAILURES" envDefault:"20"`        // Failures per IP address before lockout
+	LoginFailureWindowMin int `env:"LOGIN_FAILURE_WINDOW_MINUTES" envDefault:"15"` // Period over which failures are counted
+	LoginLockoutMin       int `env:"LOGIN_LOCKOUT_MINUTES" envDefault:"15"`        // Lockout duration
+
+	// Email verification
+	EmailVerificationURL       string `env:"EMAIL_VERIFICATION_URL" envDefault:"http://localhost:3000/verify-email"` // Page receiving the token in its "token" query parameter
+	EmailVerificationTTLMin    int    `env:"EMAIL_VERIFICATION_TTL_MINUTES" envDefault:"1440"`                       // How long verification links stay valid
+	EmailVerificationResendSec int    `env:"EMAIL_VERIFICATION_RESEND_SECONDS" envDefault:"60"`                      // Minimum interval between verification emails to a user
+
+	// Password reset
+	PasswordResetURL    string `env:"PASSWORD_RESET_URL" envDefault:"http://localhost:3000/reset-password"` // Page receiving the token in its "token" query parameter
+	PasswordResetTTLMin int    `env:"PASSWORD_RESET_TTL_MINUTES" envDefault:"30"`                           // How long reset links stay valid
+
+	// Password policy
+	PasswordMinLength            int    `env:"PASSWORD_MIN_LENGTH" envDefault:"8"`                           // Minimum password length in characters
+	PasswordMaxLength            int    `env:"PASSWORD_MAX_LENGTH" envDefault:"72"`                          // Maximum password length in characters, at most 72
+	PasswordMinCharClasses       int    `env:"PASSWORD_MIN_CHAR_CLASSES" envDefault:"2"`                     // Required classes among lowercase, uppercase, digits and symbols
+	PasswordDisallowPersonalInfo bool   `env:"PASSWORD_DISALLOW_PERSONAL_INFO" envDefault:"true"`            // Reject passwords containing the user's name or email
+	PasswordBreachedDir          string `env:"PASSWORD_BREACHED_DIR" envDefault:"./data/breached-passwords"` // Directory of breached-password range files; empty to skip the check
+
+	// Multi-factor authentication
+	MFAIssuer          string `env:"MFA_ISSUER" envDefault:"Example Fiber API"` // Service name shown in authenticator apps
+	MFAChallengeTTLMin int    `env:"MFA_CHALLENGE_TTL_MINUTES" envDefault:"5"`  // Time allowed to enter the code after the password
+	MFARequiredAdmin   bool   `env:"MFA_REQUIRED_FOR_ADMIN" envDefault:"false"` // Require an MFA sign-in for admin-only routes
+
+	// Sessions of signed-in users
+	SessionTTLHours int `env:"SESSION_TTL_HOURS" envDefault:"720"` // Lifetime of a session and its refresh tokens, whatever their use
+
+	// Tenants, the customer organizations hosted on the deployment
+	TenantBaseDomain string `env:"TENANT_BASE_DOMAIN"` // Domain whose subdomains name tenants, such as "example.com" for acme.example.com; empty to only use headers and tokens
+
+	// Invitations to the teams of organizations
+	OrgInvitationURL      string `env:"ORG_INVITATION_URL" envDefault:"http://localhost:3000/accept-invitation"` // Page receiving the token in its "token" query parameter
+	OrgInvitationTTLHours int    `env:"ORG_INVITATION_TTL_HOURS" envDefault:"168"`                               // How long invitation links stay valid
+
+	// Administrators acting as a user
+	ImpersonationTTLMin int `env:"IMPERSONATION_TTL_MINUTES" envDefault:"15"` // Lifetime of impersonation tokens
+
+	// OAuth2 authorization server for registered clients
+	OAuthAccessTokenTTLMin    int `env:"OAUTH_ACCESS_TOKEN_TTL_MINUTES" envDefault:"15"` // Lifetime of access tokens issued to clients
+	OAuthRefreshTokenTTLHours int `env:"OAUTH_REFRESH_TOKEN_TTL_HOURS" envDefault:"720"` // Lifetime of refresh tokens, which are rotated on use
+
+	// Outgoing mail
+	MailDir string `env:"MAIL_DIR"` // Directory receiving outgoing mail as .eml files; empty to write mail to the log
+
+	secretFiles map[string]string // File path per secret setting loaded through its *_FILE variant
 }
 
-// New creates a new application configuration by parsing environment variables.
-// It returns a fully initialized Config struct with default values applied where needed.
-// Exits the application with an error if environment variables can't be parsed.
-func New() *Config {
-	cfg := &Config{}
-	if err := env.Parse(cfg); err != nil {
-		log.Fatalf("Failed to parse environment variables: %v", err)
+// SecretFile returns the file a secret setting was loaded from, such as
+// "JWT_SECRET", or an empty string if it was not set through its *_FILE variant.
+func (c *Config) SecretFile(key string) string {
+	return c.secretFiles[key]
+}
+
+// New creates a new application configuration from the command-line arguments,
+// the optional configuration file and environment variables.
+// It returns the validated Config along with the Loader used to reload it.
+// Exits the application with an error if the configuration can't be loaded
+// or if the resulting configuration is invalid for its environment.
+func New(args []string) (*Config, *Loader) {
+	loader, err := NewLoader(args)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		log.Fatalf("Failed to parse command-line flags: %v", err)
+	}
+
+	cfg, err := loader.Load()
+	if err != nil {
+		log.Fatalf("Refusing to start with %v", err)
 	}
 
-	log.Printf("Configuration loaded: %+v", cfg)
-	return cfg
+	log.Printf("Configuration loaded: %s", cfg)
+	return cfg, loader
 }
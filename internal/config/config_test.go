@@ -0,0 +1,267 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// validConfig returns a configuration that passes validation in every environment
+func validConfig(environment string) *Config {
+	return &Config{
+		ServerAddress:              ":8080",
+		Environment:                environment,
+		LogLevel:                   "info",
+		JWTSecret:                  strings.Repeat("s", minSecretLength),
+		JWTExpirationHours:         24,
+		JWTIssuer:                  "example-golang-api",
+		JWTAudience:                "example-golang-api",
+		ShutdownTimeoutSec:         15,
+		RequestTimeoutSec:          10,
+		CORSAllowOrigins:           []string{"http://localhost:3000"},
+		RateLimitWindowSec:         60,
+		RateLimitGlobalMax:         600,
+		RateLimitLoginMax:          10,
+		RateLimitReadMax:           300,
+		RateLimitWriteMax:          60,
+		LoginMaxFailures:           5,
+		LoginIPMaxFailures:         20,
+		LoginFailureWindowMin:      15,
+		LoginLockoutMin:            15,
+		EmailVerificationURL:       "https://app.example.com/verify-email",
+		EmailVerificationTTLMin:    1440,
+		EmailVerificationResendSec: 60,
+		PasswordResetURL:           "https://app.example.com/reset-password",
+		PasswordResetTTLMin:        30,
+		PasswordMinLength:          8,
+		PasswordMaxLength:          72,
+		PasswordMinCharClasses:     2,
+		MFAIssuer:                  "Example Fiber API",
+		MFAChallengeTTLMin:         5,
+		SessionTTLHours:            720,
+		OrgInvitationURL:           "https://app.example.com/accept-invitation",
+		OrgInvitationTTLHours:      168,
+		ImpersonationTTLMin:        15,
+		OAuthAccessTokenTTLMin:     15,
+		OAuthRefreshTokenTTLHours:  720,
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		modify     func(c *Config)
+		wantFields []string
+	}{
+		{
+			name:   "Valid Production",
+			modify: func(c *Config) { c.Environment = EnvProduction },
+		},
+		{
+			name: "Default Secret Allowed In Development",
+			modify: func(c *Config) {
+				c.Environment = EnvDevelopment
+				c.JWTSecret = "mysecretkey"
+			},
+		},
+		{
+			name: "Default Secret Rejected In Production",
+			modify: func(c *Config) {
+				c.Environment = EnvProduction
+				c.JWTSecret = "mysecretkey"
+			},
+			wantFields: []string{"JWT_SECRET", "JWT_SECRET"},
+		},
+		{
+			name: "Long Expiration Rejected In Production",
+			modify: func(c *Config) {
+				c.Environment = EnvProduction
+				c.JWTExpirationHours = 48
+			},
+			wantFields: []string{"JWT_EXPIRATION_HOURS"},
+		},
+		{
+			name:       "Tenant Base Domain With Scheme Rejected",
+			modify:     func(c *Config) { c.TenantBaseDomain = "https://example.com" },
+			wantFields: []string{"TENANT_BASE_DOMAIN"},
+		},
+		{
+			name: "Every Invalid Field Reported",
+			modify: func(c *Config) {
+				c.Environment = "prod"
+				c.ServerAddress = "8080"
+				c.LogLevel = "verbose"
+				c.RateLimitLoginMax = 0
+			},
+			wantFields: []string{"ENVIRONMENT", "SERVER_ADDRESS", "LOG_LEVEL", "RATE_LIMIT_LOGIN_MAX"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig(EnvDevelopment)
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() error = %v, want *ValidationError", err)
+			}
+
+			var gotFields []string
+			for _, fe := range validationErr.Errors {
+				gotFields = append(gotFields, fe.Field)
+			}
+			if strings.Join(gotFields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("Validate() fields = %v, want %v", gotFields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestConfigStringRedactsSecrets(t *testing.T) {
+	cfg := validConfig(EnvProduction)
+	cfg.JWTSecret = "super-secret-signing-key-value-1234"
+
+	out := cfg.String()
+	if strings.Contains(out, cfg.JWTSecret) {
+		t.Errorf("String() = %v, must not contain the secret", out)
+	}
+	if !strings.Contains(out, "JWTSecret:[REDACTED]") {
+		t.Errorf("String() = %v, want redacted JWTSecret", out)
+	}
+}
+
+func TestLoaderLayering(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	file := "log_level: warn\nrate_limit:\n  login_max: 5\n  read_max: 100\n"
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatalf("WriteFile() unexpected error = %v", err)
+	}
+
+	t.Setenv("RATE_LIMIT_READ_MAX", "200")
+	t.Setenv("RATE_LIMIT_WRITE_MAX", "30")
+
+	loader, err := NewLoader([]string{"--config", path, "--rate-limit-write-max", "40"})
+	if err != nil {
+		t.Fatalf("NewLoader() unexpected error = %v", err)
+	}
+	cfg, err := loader.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error = %v", err)
+	}
+
+	if cfg.LogLevel != "warn" || cfg.RateLimitLoginMax != 5 {
+		t.Errorf("file values not applied: LogLevel = %v, RateLimitLoginMax = %v", cfg.LogLevel, cfg.RateLimitLoginMax)
+	}
+	if cfg.RateLimitReadMax != 200 {
+		t.Errorf("RateLimitReadMax = %v, want environment value 200", cfg.RateLimitReadMax)
+	}
+	if cfg.RateLimitWriteMax != 40 {
+		t.Errorf("RateLimitWriteMax = %v, want flag value 40", cfg.RateLimitWriteMax)
+	}
+}
+
+func TestLoaderRejectsUnknownFileKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte("log_levl = \"debug\"\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() unexpected error = %v", err)
+	}
+
+	loader, err := NewLoader([]string{"--config", path})
+	if err != nil {
+		t.Fatalf("NewLoader() unexpected error = %v", err)
+	}
+	if _, err := loader.Load(); err == nil || !strings.Contains(err.Error(), "log_levl") {
+		t.Errorf("Load() error = %v, want unknown key log_levl", err)
+	}
+}
+
+func TestLoaderSecretFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt_secret")
+	secret := strings.Repeat("k", minSecretLength)
+	if err := os.WriteFile(path, []byte(secret+"\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() unexpected error = %v", err)
+	}
+
+	t.Run("Value Read From File", func(t *testing.T) {
+		t.Setenv("JWT_SECRET_FILE", path)
+
+		loader, err := NewLoader(nil)
+		if err != nil {
+			t.Fatalf("NewLoader() unexpected error = %v", err)
+		}
+		cfg, err := loader.Load()
+		if err != nil {
+			t.Fatalf("Load() unexpected error = %v", err)
+		}
+		if cfg.JWTSecret != secret {
+			t.Errorf("JWTSecret = %q, want file contents", cfg.JWTSecret)
+		}
+		if cfg.SecretFile("JWT_SECRET") != path {
+			t.Errorf("SecretFile() = %q, want %q", cfg.SecretFile("JWT_SECRET"), path)
+		}
+	})
+
+	t.Run("Value And File Both Set", func(t *testing.T) {
+		t.Setenv("JWT_SECRET_FILE", path)
+		t.Setenv("JWT_SECRET", secret)
+
+		loader, err := NewLoader(nil)
+		if err != nil {
+			t.Fatalf("NewLoader() unexpected error = %v", err)
+		}
+		if _, err := loader.Load(); err == nil {
+			t.Errorf("Load() error = nil, want error when both are set")
+		}
+	})
+
+	t.Run("File Overrides Value Of Lower Layer", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(configPath, []byte("jwt_secret: "+strings.Repeat("f", minSecretLength)+"\n"), 0o600); err != nil {
+			t.Fatalf("WriteFile() unexpected error = %v", err)
+		}
+		t.Setenv("JWT_SECRET_FILE", path)
+
+		loader, err := NewLoader([]string{"--config", configPath})
+		if err != nil {
+			t.Fatalf("NewLoader() unexpected error = %v", err)
+		}
+		cfg, err := loader.Load()
+		if err != nil {
+			t.Fatalf("Load() unexpected error = %v", err)
+		}
+		if cfg.JWTSecret != secret || cfg.SecretFile("JWT_SECRET") != path {
+			t.Errorf("JWTSecret = %q from %q, want file contents from %q", cfg.JWTSecret, cfg.SecretFile("JWT_SECRET"), path)
+		}
+	})
+
+	t.Run("Value Overrides File Of Lower Layer", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(configPath, []byte("jwt_secret_file: "+path+"\n"), 0o600); err != nil {
+			t.Fatalf("WriteFile() unexpected error = %v", err)
+		}
+		t.Setenv("JWT_SECRET", strings.Repeat("e", minSecretLength))
+
+		loader, err := NewLoader([]string{"--config", configPath})
+		if err != nil {
+			t.Fatalf("NewLoader() unexpected error = %v", err)
+		}
+		cfg, err := loader.Load()
+		if err != nil {
+			t.Fatalf("Load() unexpected error = %v", err)
+		}
+		if cfg.JWTSecret != strings.Repeat("e", minSecretLength) || cfg.SecretFile("JWT_SECRET") != "" {
+			t.Errorf("JWTSecret = %q from %q, want environment value", cfg.JWTSecret, cfg.SecretFile("JWT_SECRET"))
+		}
+	})
+}
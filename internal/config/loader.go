@@ -0,0 +1,346 @@
+package config
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/caarlos0/env/v6"
+	"gopkg.in/yaml.v3"
+)
+
+// watchInterval is how often the configuration file is checked for changes
+const watchInterval = 2 * time.Second
+
+// secretFileSuffix marks the variant of a secret setting that names a file holding its value
+const secretFileSuffix = "_FILE"
+
+// Loader builds a Config from layered sources, later layers overriding earlier ones:
+//
+//  1. defaults declared with envDefault tags
+//  2. the configuration file given by --config or CONFIG_FILE (YAML or TOML)
+//  3. environment variables
+//  4. command-line flags
+//
+// Every secret setting also accepts a *_FILE variant naming a file that holds
+// the value (JWT_SECRET_FILE=/run/secrets/jwt_secret), for Docker and Kubernetes
+// secret mounts. The variant belongs to the layer it is set in, so a later layer
+// overrides it like any other setting. Setting both the value and its file in
+// the same layer is an error.
+//
+// File keys and flag names are derived from the environment variable names:
+// JWT_EXPIRATION_HOURS is "jwt_expiration_hours" in a file and
+// --jwt-expiration-hours on the command line. Nested file sections are joined
+// with an underscore, so rate_limit: {login_max: 5} sets RATE_LIMIT_LOGIN_MAX.
+type Loader struct {
+	path  string            // Configuration file path, empty when none is used
+	flags map[string]string // Values set on the command line, keyed by environment variable name
+}
+
+// NewLoader parses the command-line arguments and returns a loader for them.
+// Secret settings cannot be passed as flags, as they would be visible in the process list,
+// but their *_FILE variants can.
+func NewLoader(args []string) (*Loader, error) {
+	fs := flag.NewFlagSet("api", flag.ContinueOnError)
+
+	path := fs.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or TOML configuration file")
+
+	keys := make(map[string]string)
+	for _, key := range settingKeys(false) {
+		fs.String(flagName(key), "", "overrides "+key)
+		keys[flagName(key)] = key
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	l := &Loader{
+		path:  *path,
+		flags: make(map[string]string),
+	}
+	fs.Visit(func(f *flag.Flag) {
+		if key, ok := keys[f.Name]; ok {
+			l.flags[key] = f.Value.String()
+		}
+	})
+
+	return l, nil
+}
+
+// Path returns the configuration file path, or an empty string if none is used.
+func (l *Loader) Path() string {
+	return l.path
+}
+
+// Load reads every configuration layer and returns the validated Config.
+func (l *Loader) Load() (*Config, error) {
+	var layers []layer
+
+	if l.path != "" {
+		fileValues, err := readFile(l.path)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, layer{name: "config file " + l.path, values: fileValues})
+	}
+
+	envValues := make(map[string]string)
+	for _, entry := range os.Environ() {
+		if key, value, ok := strings.Cut(entry, "="); ok {
+			envValues[key] = value
+		}
+	}
+	layers = append(layers, layer{name: "environment", values: envValues})
+	layers = append(layers, layer{name: "command line", values: maps.Clone(l.flags)})
+
+	values := make(map[string]string)
+	secretFiles := make(map[string]string)
+	for _, source := range layers {
+		files, err := readSecretFiles(source)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range source.values {
+			values[key] = value
+		}
+
+		// A secret set by this layer replaces the file a lower layer may have named for it
+		for _, key := range secretKeys() {
+			if _, ok := source.values[key]; !ok {
+				continue
+			}
+			if path, ok := files[key]; ok {
+				secretFiles[key] = path
+			} else {
+				delete(secretFiles, key)
+			}
+		}
+	}
+
+	cfg := &Config{secretFiles: secretFiles}
+	if err := env.Parse(cfg, env.Options{Environment: values}); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Watch reloads the configuration when the file changes or the process
+// receives SIGHUP, until ctx is canceled. Valid configurations are passed to
+// onReload; invalid ones are logged and ignored, keeping the current settings.
+// It is meant to be run as a background worker.
+func (l *Loader) Watch(ctx context.Context, onReload func(*Config)) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	lastMod := l.modTime()
+	reload := func(reason string) {
+		cfg, err := l.Load()
+		if err != nil {
+			log.Printf("Configuration reload (%s) rejected: %v", reason, err)
+			return
+		}
+		log.Printf("Configuration reloaded (%s): %s", reason, cfg)
+		onReload(cfg)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			lastMod = l.modTime()
+			reload("SIGHUP")
+		case <-ticker.C:
+			if mod := l.modTime(); !mod.Equal(lastMod) {
+				lastMod = mod
+				reload("file changed")
+			}
+		}
+	}
+}
+
+// modTime returns the modification time of the configuration file, or the zero time
+func (l *Loader) modTime() time.Time {
+	if l.path == "" {
+		return time.Time{}
+	}
+	info, err := os.Stat(l.path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// readFile parses a YAML or TOML configuration file into environment-style key/value pairs
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	raw := make(map[string]any)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	default:
+		return nil, fmt.Errorf("unsupported config file format %q, use .yaml, .yml or .toml", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	flatten("", raw, values)
+
+	// Reject unknown keys so typos do not silently fall back to defaults
+	known := make(map[string]bool)
+	for _, key := range settingKeys(true) {
+		known[key] = true
+	}
+	var unknown []string
+	for key := range values {
+		if !known[key] {
+			unknown = append(unknown, strings.ToLower(key))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown settings in config file %s: %s", path, strings.Join(unknown, ", "))
+	}
+
+	return values, nil
+}
+
+// layer is one configuration source, with its values keyed by environment variable name
+type layer struct {
+	name   string // Where the values come from, used in error messages
+	values map[string]string
+}
+
+// readSecretFiles replaces every secret set through a *_FILE variant in the layer
+// with the contents of its file, and returns the file path used for each secret
+func readSecretFiles(l layer) (map[string]string, error) {
+	files := make(map[string]string)
+	for _, key := range secretKeys() {
+		path := l.values[key+secretFileSuffix]
+		if path == "" {
+			continue
+		}
+		if _, ok := l.values[key]; ok {
+			return nil, fmt.Errorf("%s and %s%s are both set in the %s, use only one", key, key, secretFileSuffix, l.name)
+		}
+
+		value, err := readSecretFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s%s: %w", key, secretFileSuffix, err)
+		}
+		l.values[key] = value
+		files[key] = path
+	}
+	return files, nil
+}
+
+// readSecretFile returns the contents of a secret file without trailing newlines.
+// Empty files are rejected so a missing mount is not mistaken for an empty secret.
+func readSecretFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	value := strings.TrimRight(string(data), "\r\n")
+	if value == "" {
+		return "", fmt.Errorf("secret file %s is empty", path)
+	}
+	return value, nil
+}
+
+// flatten converts nested file sections into environment-style keys
+func flatten(prefix string, raw map[string]any, values map[string]string) {
+	for key, value := range raw {
+		name := strings.ToUpper(key)
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+
+		switch v := value.(type) {
+		case map[string]any:
+			flatten(name, v, values)
+		case []any:
+			items := make([]string, len(v))
+			for i, item := range v {
+				items[i] = fmt.Sprint(item)
+			}
+			values[name] = strings.Join(items, ",")
+		default:
+			values[name] = fmt.Sprint(v)
+		}
+	}
+}
+
+// settingKeys returns the environment variable names of all settings,
+// optionally including the ones tagged as secret. The *_FILE variants of
+// secret settings are always included.
+func settingKeys(includeSecrets bool) []string {
+	t := reflect.TypeOf(Config{})
+
+	keys := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("env"), ",")
+		if key == "" {
+			continue
+		}
+		if field.Tag.Get("secret") == "true" {
+			keys = append(keys, key+secretFileSuffix)
+			if !includeSecrets {
+				continue
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// secretKeys returns the environment variable names of the settings tagged as secret
+func secretKeys() []string {
+	t := reflect.TypeOf(Config{})
+
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Tag.Get("secret") == "true" {
+			key, _, _ := strings.Cut(field.Tag.Get("env"), ",")
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// flagName converts an environment variable name into a command-line flag name
+func flagName(key string) string {
+	return strings.ReplaceAll(strings.ToLower(key), "_", "-")
+}
@@ -0,0 +1,240 @@
+package config
+
+import (
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"net"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Supported runtime environments
+const (
+	EnvDevelopment = "development"
+	EnvStaging     = "staging"
+	EnvProduction  = "production"
+)
+
+// minSecretLength is the minimum secret length outside development
+const minSecretLength = 32
+
+// insecureSecrets are well-known placeholder secrets shipped with the project.
+// They must never be used outside development.
+var insecureSecrets = []string{
+	"mysecretkey",
+	"add_a_strong_secret_key_here",
+	"change_this_to_a_secure_secret_in_production",
+}
+
+// validLogLevels lists the accepted LOG_LEVEL values
+var validLogLevels = []string{"debug", "info", "warn", "error"}
+
+// FieldError describes a single invalid configuration setting.
+type FieldError struct {
+	Field   string // Environment variable name of the setting
+	Message string // Why the value is invalid
+}
+
+// ValidationError aggregates every invalid setting found during validation.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+// Error implements the error interface for ValidationError.
+// Returns one line per invalid setting so all problems can be fixed at once.
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid configuration (%d problems):", len(e.Errors))
+	for _, fe := range e.Errors {
+		fmt.Fprintf(&b, "\n  - %s: %s", fe.Field, fe.Message)
+	}
+	return b.String()
+}
+
+// add records an invalid setting
+func (e *ValidationError) add(field, format string, args ...any) {
+	e.Errors = append(e.Errors, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// IsProduction reports whether the configuration targets production.
+func (c *Config) IsProduction() bool {
+	return c.Environment == EnvProduction
+}
+
+// Validate checks the configuration against the rules of its environment.
+// It returns a *ValidationError listing every invalid setting, or nil.
+func (c *Config) Validate() error {
+	errs := &ValidationError{}
+
+	switch c.Environment {
+	case EnvDevelopment, EnvStaging, EnvProduction:
+	default:
+		errs.add("ENVIRONMENT", "must be one of %s, %s or %s", EnvDevelopment, EnvStaging, EnvProduction)
+	}
+
+	if _, port, err := net.SplitHostPort(c.ServerAddress); err != nil {
+		errs.add("SERVER_ADDRESS", "must be in host:port form: %v", err)
+	} else if n, err := strconv.Atoi(port); err != nil || n < 0 || n > 65535 {
+		errs.add("SERVER_ADDRESS", "port must be a number between 0 and 65535")
+	}
+
+	if !slices.Contains(validLogLevels, strings.ToLower(c.LogLevel)) {
+		errs.add("LOG_LEVEL", "must be one of %s", strings.Join(validLogLevels, ", "))
+	}
+
+	c.validateSecret(errs, "JWT_SECRET", c.JWTSecret)
+
+	maxExpiration := 720
+	if c.IsProduction() {
+		maxExpiration = 24
+	}
+	if c.JWTExpirationHours < 1 || c.JWTExpirationHours > maxExpiration {
+		errs.add("JWT_EXPIRATION_HOURS", "must be between 1 and %d in %s", maxExpiration, c.Environment)
+	}
+
+	if strings.TrimSpace(c.JWTIssuer) == "" {
+		errs.add("JWT_ISSUER", "must not be empty")
+	}
+	if strings.TrimSpace(c.JWTAudience) == "" {
+		errs.add("JWT_AUDIENCE", "must not be empty")
+	}
+
+	if c.ShutdownTimeoutSec < 1 || c.ShutdownTimeoutSec > 300 {
+		errs.add("SHUTDOWN_TIMEOUT_SECONDS", "must be between 1 and 300")
+	}
+
+	if c.RequestTimeoutSec < 1 || c.RequestTimeoutSec > 300 {
+		errs.add("REQUEST_TIMEOUT_SECONDS", "must be between 1 and 300")
+	}
+
+	if len(c.CORSAllowOrigins) == 0 {
+		errs.add("CORS_ALLOW_ORIGINS", "must list at least one origin")
+	}
+	for _, origin := range c.CORSAllowOrigins {
+		if origin == "*" && c.CORSAllowCredentials {
+			errs.add("CORS_ALLOW_ORIGINS", "must not be \"*\" when CORS_ALLOW_CREDENTIALS is enabled")
+		}
+	}
+
+	positive := []struct {
+		field string
+		value int
+	}{
+		{"RATE_LIMIT_WINDOW_SECONDS", c.RateLimitWindowSec},
+		{"RATE_LIMIT_GLOBAL_MAX", c.RateLimitGlobalMax},
+		{"RATE_LIMIT_LOGIN_MAX", c.RateLimitLoginMax},
+		{"RATE_LIMIT_READ_MAX", c.RateLimitReadMax},
+		{"RATE_LIMIT_WRITE_MAX", c.RateLimitWriteMax},
+		{"LOGIN_MAX_FAILURES", c.LoginMaxFailures},
+		{"LOGIN_IP_MAX_FAILURES", c.LoginIPMaxFailures},
+		{"LOGIN_FAILURE_WINDOW_MINUTES", c.LoginFailureWindowMin},
+		{"LOGIN_LOCKOUT_MINUTES", c.LoginLockoutMin},
+		{"EMAIL_VERIFICATION_TTL_MINUTES", c.EmailVerificationTTLMin},
+		{"EMAIL_VERIFICATION_RESEND_SECONDS", c.EmailVerificationResendSec},
+		{"PASSWORD_RESET_TTL_MINUTES", c.PasswordResetTTLMin},
+		{"MFA_CHALLENGE_TTL_MINUTES", c.MFAChallengeTTLMin},
+		{"SESSION_TTL_HOURS", c.SessionTTLHours},
+		{"ORG_INVITATION_TTL_HOURS", c.OrgInvitationTTLHours},
+		{"IMPERSONATION_TTL_MINUTES", c.ImpersonationTTLMin},
+		{"OAUTH_ACCESS_TOKEN_TTL_MINUTES", c.OAuthAccessTokenTTLMin},
+		{"OAUTH_REFRESH_TOKEN_TTL_HOURS", c.OAuthRefreshTokenTTLHours},
+	}
+	for _, p := range positive {
+		if p.value < 1 {
+			errs.add(p.field, "must be greater than 0")
+		}
+	}
+
+	if c.PasswordMinLength < 1 || c.PasswordMinLength > c.PasswordMaxLength {
+		errs.add("PASSWORD_MIN_LENGTH", "must be between 1 and PASSWORD_MAX_LENGTH")
+	}
+	if c.PasswordMaxLength > model.MaxPasswordBytes {
+		errs.add("PASSWORD_MAX_LENGTH", "must be at most %d", model.MaxPasswordBytes)
+	}
+	if c.PasswordMinCharClasses < 0 || c.PasswordMinCharClasses > 4 {
+		errs.add("PASSWORD_MIN_CHAR_CLASSES", "must be between 0 and 4")
+	}
+
+	if strings.TrimSpace(c.MFAIssuer) == "" || strings.Contains(c.MFAIssuer, ":") {
+		errs.add("MFA_ISSUER", "must not be empty or contain a colon")
+	}
+
+	links := []struct {
+		field string
+		value string
+	}{
+		{"EMAIL_VERIFICATION_URL", c.EmailVerificationURL},
+		{"PASSWORD_RESET_URL", c.PasswordResetURL},
+		{"ORG_INVITATION_URL", c.OrgInvitationURL},
+	}
+	for _, l := range links {
+		if u, err := url.Parse(l.value); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs.add(l.field, "must be an absolute http or https URL")
+		}
+	}
+
+	if c.TenantBaseDomain != "" && (strings.ContainsAny(c.TenantBaseDomain, "/: ") || strings.HasPrefix(c.TenantBaseDomain, ".")) {
+		errs.add("TENANT_BASE_DOMAIN", "must be a domain name without scheme, port or leading dot")
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateSecret checks a secret setting, such as "JWT_SECRET", against the rules
+// of the configuration's environment. It is meant for secrets read again while the
+// server is running, and returns a *ValidationError or nil.
+func (c *Config) ValidateSecret(field, secret string) error {
+	errs := &ValidationError{}
+	c.validateSecret(errs, field, secret)
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateSecret applies the secret rules of the current environment
+func (c *Config) validateSecret(errs *ValidationError, field, secret string) {
+	if secret == "" {
+		errs.add(field, "must not be empty")
+		return
+	}
+
+	if c.Environment == EnvDevelopment {
+		return
+	}
+
+	if slices.Contains(insecureSecrets, secret) {
+		errs.add(field, "must not use a default placeholder value in %s", c.Environment)
+	}
+	if len(secret) < minSecretLength {
+		errs.add(field, "must be at least %d characters long in %s", minSecretLength, c.Environment)
+	}
+}
+
+// String returns a printable form of the configuration with every field
+// tagged `secret:"true"` redacted, so it is safe to write to logs.
+func (c *Config) String() string {
+	v := reflect.ValueOf(*c)
+	t := v.Type()
+
+	parts := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := fmt.Sprintf("%v", v.Field(i).Interface())
+		if field.Tag.Get("secret") == "true" && value != "" {
+			value = "[REDACTED]"
+		}
+		parts = append(parts, field.Name+":"+value)
+	}
+
+	return "{" + strings.Join(parts, " ") + "}"
+}
@@ -0,0 +1,25 @@
+package event
+
+import (
+	"context"
+	"time"
+)
+
+// Event is something that happened in the domain that other parts of the system may react to.
+type Event interface {
+	// Name identifies the kind of event, e.g. "user.status_changed"
+	Name() string
+
+	// OccurredAt returns when the event happened
+	OccurredAt() time.Time
+}
+
+// Handler reacts to a published event.
+type Handler func(ctx context.Context, e Event) error
+
+// Publisher delivers domain events to their subscribers.
+// Domain services publish the events recorded by an aggregate once it is saved.
+type Publisher interface {
+	// Publish delivers the events in order
+	Publish(ctx context.Context, events ...Event)
+}
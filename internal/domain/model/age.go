@@ -0,0 +1,27 @@
+package model
+
+import "errors"
+
+// Age limits
+const (
+	MinAge = 0
+	MaxAge = 120
+)
+
+// Age is a validated age value object.
+type Age struct {
+	value int
+}
+
+// NewAge validates an age.
+func NewAge(years int) (Age, error) {
+	if years < MinAge || years > MaxAge {
+		return Age{}, errors.New("age must be between 0 and 120")
+	}
+	return Age{value: years}, nil
+}
+
+// Int returns the age in years.
+func (a Age) Int() int {
+	return a.value
+}
@@ -0,0 +1,163 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// API key errors
+var (
+	ErrInvalidAPIKeyData = errors.New("invalid API key data")    // A new key has no name, scopes or a past expiry
+	ErrAPIKeyRevoked     = errors.New("API key already revoked") // Revoking a key twice
+)
+
+// APIKey lets a service call the API without a user's credentials.
+// The key handed out is the public prefix followed by a random secret; only a
+// hash of the secret is stored, and the prefix is used to look the key up.
+// Keys are granted scopes, may expire, and are kept after being revoked so
+// that they remain visible in listings and audits.
+type APIKey struct {
+	id         int
+	tenantID   string // Tenant the key gives access to
+	name       string
+	prefix     string    // Public identifier, the start of the key
+	secretHash string    // Hash of the secret part of the key
+	scopes     []string  // Granted scopes, never modified after creation
+	createdBy  string    // Who created the key
+	createdAt  time.Time // When the key was created
+	expiresAt  time.Time // When the key stops working, zero if it never expires
+	lastUsedAt time.Time // When the key was last used, zero if never
+	revokedAt  time.Time // When the key was revoked, zero if it is not
+}
+
+// NewAPIKey creates an API key for a tenant. expiresAt may be zero for a key that never expires.
+func NewAPIKey(tenantID, name, prefix, secretHash string, scopes []string, createdBy string, now, expiresAt time.Time) (*APIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidAPIKeyData)
+	}
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", ErrInvalidAPIKeyData)
+	}
+	if err := ValidateScopes(scopes); err != nil {
+		return nil, err
+	}
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expiry must be in the future", ErrInvalidAPIKeyData)
+	}
+
+	scopes = slices.Clone(scopes)
+	slices.Sort(scopes)
+	return &APIKey{
+		tenantID:   tenantID,
+		name:       name,
+		prefix:     prefix,
+		secretHash: secretHash,
+		scopes:     slices.Compact(scopes),
+		createdBy:  createdBy,
+		createdAt:  now,
+		expiresAt:  expiresAt,
+	}, nil
+}
+
+// AssignID sets the identifier of a new key when it is first persisted.
+// It fails if the key already has an identifier.
+func (k *APIKey) AssignID(id int) error {
+	if k.id != 0 {
+		return fmt.Errorf("API key already has id %d", k.id)
+	}
+	k.id = id
+	return nil
+}
+
+// ID returns the key's identifier.
+func (k *APIKey) ID() int {
+	return k.id
+}
+
+// TenantID returns the tenant the key gives access to.
+func (k *APIKey) TenantID() string {
+	return k.tenantID
+}
+
+// Name returns the name describing what the key is used for.
+func (k *APIKey) Name() string {
+	return k.name
+}
+
+// Prefix returns the public identifier of the key.
+func (k *APIKey) Prefix() string {
+	return k.prefix
+}
+
+// SecretHash returns the hash of the key's secret.
+func (k *APIKey) SecretHash() string {
+	return k.secretHash
+}
+
+// Scopes returns the scopes granted to the key.
+func (k *APIKey) Scopes() []string {
+	return slices.Clone(k.scopes)
+}
+
+// HasScope reports whether the key was granted scope.
+func (k *APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.scopes, scope)
+}
+
+// CreatedBy returns who created the key.
+func (k *APIKey) CreatedBy() string {
+	return k.createdBy
+}
+
+// CreatedAt returns when the key was created.
+func (k *APIKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+// ExpiresAt returns when the key stops working, or the zero time if it never expires.
+func (k *APIKey) ExpiresAt() time.Time {
+	return k.expiresAt
+}
+
+// LastUsedAt returns when the key was last used, or the zero time if never.
+func (k *APIKey) LastUsedAt() time.Time {
+	return k.lastUsedAt
+}
+
+// RevokedAt returns when the key was revoked, or the zero time if it is not.
+func (k *APIKey) RevokedAt() time.Time {
+	return k.revokedAt
+}
+
+// IsRevoked reports whether the key was revoked.
+func (k *APIKey) IsRevoked() bool {
+	return !k.revokedAt.IsZero()
+}
+
+// IsExpired reports whether the key has expired at the given time.
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return !k.expiresAt.IsZero() && !now.Before(k.expiresAt)
+}
+
+// IsActive reports whether the key can be used at the given time.
+func (k *APIKey) IsActive(now time.Time) bool {
+	return !k.IsRevoked() && !k.IsExpired(now)
+}
+
+// MarkUsed records that the key was used at the given time.
+func (k *APIKey) MarkUsed(now time.Time) {
+	k.lastUsedAt = now
+}
+
+// Revoke disables the key permanently.
+func (k *APIKey) Revoke(now time.Time) error {
+	if k.IsRevoked() {
+		return ErrAPIKeyRevoked
+	}
+	k.revokedAt = now
+	return nil
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		keyName   string
+		scopes    []string
+		expiresAt time.Time
+		wantErr   error
+	}{
+		{"Valid", "sync", []string{ScopeUsersRead}, time.Time{}, nil},
+		{"Valid With Expiry", "sync", []string{ScopeUsersRead, ScopeUsersWrite}, now.Add(time.Hour), nil},
+		{"Blank Name", "  ", []string{ScopeUsersRead}, time.Time{}, ErrInvalidAPIKeyData},
+		{"No Scopes", "sync", nil, time.Time{}, ErrInvalidAPIKeyData},
+		{"Unknown Scope", "sync", []string{"users:delete"}, time.Time{}, ErrInvalidScope},
+		{"Client Only Scope", "sync", []string{ScopeTokensIntrospect}, time.Time{}, ErrInvalidScope},
+		{"Past Expiry", "sync", []string{ScopeUsersRead}, now.Add(-time.Hour), ErrInvalidAPIKeyData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAPIKey(DefaultTenantID, tt.keyName, "ak_1", "hash", tt.scopes, "admin", now, tt.expiresAt)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewAPIKey() error = %v, want %v", err, tt.wantErr)
+			}
+			// Only the scopes an API key can be granted are suggested
+			if errors.Is(err, ErrInvalidScope) && !strings.HasSuffix(err.Error(), "must be one of "+strings.Join(Scopes, ", ")) {
+				t.Errorf("NewAPIKey() error = %v, want the API key scopes named", err)
+			}
+		})
+	}
+}
+
+func TestAPIKeyLifecycle(t *testing.T) {
+	now := time.Now()
+	key, err := NewAPIKey(DefaultTenantID, "sync", "ak_1", "hash", []string{ScopeUsersWrite, ScopeUsersRead, ScopeUsersRead}, "admin", now, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("NewAPIKey() unexpected error = %v", err)
+	}
+
+	if got := key.Scopes(); len(got) != 2 || got[0] != ScopeUsersRead || got[1] != ScopeUsersWrite {
+		t.Errorf("Scopes() = %v, want sorted scopes without duplicates", got)
+	}
+	if !key.IsActive(now) {
+		t.Error("IsActive() = false for a new key")
+	}
+	if key.IsActive(now.Add(time.Hour)) {
+		t.Error("IsActive() = true at the expiry time")
+	}
+
+	if err := key.Revoke(now); err != nil {
+		t.Fatalf("Revoke() unexpected error = %v", err)
+	}
+	if key.IsActive(now) {
+		t.Error("IsActive() = true after Revoke")
+	}
+	if err := key.Revoke(now); !errors.Is(err, ErrAPIKeyRevoked) {
+		t.Errorf("Revoke() twice error = %v, want ErrAPIKeyRevoked", err)
+	}
+}
@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+// AuditEvent records a security-relevant action for later review.
+// Events are immutable once created.
+type AuditEvent struct {
+	occurredAt time.Time         // When the action happened
+	action     string            // What happened (e.g. "login.lockout")
+	actor      string            // Who performed the action
+	subject    string            // What the action was performed on
+	ip         string            // Client IP address the action originated from
+	details    map[string]string // Additional context
+}
+
+// NewAuditEvent creates a new audit event occurring now.
+func NewAuditEvent(action, actor, subject, ip string, details map[string]string) *AuditEvent {
+	copied := make(map[string]string, len(details))
+	for k, v := range details {
+		copied[k] = v
+	}
+
+	return &AuditEvent{
+		occurredAt: time.Now(),
+		action:     action,
+		actor:      actor,
+		subject:    subject,
+		ip:         ip,
+		details:    copied,
+	}
+}
+
+// OccurredAt returns when the action happened.
+func (e *AuditEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// Action returns what happened.
+func (e *AuditEvent) Action() string {
+	return e.action
+}
+
+// Actor returns who performed the action.
+func (e *AuditEvent) Actor() string {
+	return e.actor
+}
+
+// Subject returns what the action was performed on.
+func (e *AuditEvent) Subject() string {
+	return e.subject
+}
+
+// IP returns the client IP address the action originated from.
+func (e *AuditEvent) IP() string {
+	return e.ip
+}
+
+// Details returns a copy of the additional context.
+func (e *AuditEvent) Details() map[string]string {
+	copied := make(map[string]string, len(e.details))
+	for k, v := range e.details {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// ErrEmailInUse is returned when saving a user whose email address belongs to
+// another user of the tenant
+var ErrEmailInUse = errors.New("user with this email already exists")
+
+// emailRegex is a simple email format check
+var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
+
+// Email is a validated email address value object.
+// Addresses are stored in lowercase so that uniqueness checks ignore case.
+type Email struct {
+	value string
+}
+
+// NewEmail validates and normalizes an email address.
+func NewEmail(address string) (Email, error) {
+	address = strings.ToLower(strings.TrimSpace(address))
+	if !emailRegex.MatchString(address) {
+		return Email{}, errors.New("invalid email format")
+	}
+	return Email{value: address}, nil
+}
+
+// String returns the email address.
+func (e Email) String() string {
+	return e.value
+}
+
+// Equals reports whether two email addresses are the same.
+func (e Email) Equals(other Email) bool {
+	return e.value == other.value
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrEmailMismatch is returned when verifying an address that is not the user's current email
+var ErrEmailMismatch = errors.New("email address does not match the user's current email")
+
+// EmailVerification is an outstanding request for a user to confirm their email address.
+// Each user has at most one; sending a new verification link replaces it, so only the
+// most recent link can be used, and only once.
+type EmailVerification struct {
+	userID    int
+	email     Email
+	tokenID   string    // Unique ID of the token sent in the verification link
+	sentAt    time.Time // When the link was sent
+	expiresAt time.Time // When the link stops working
+}
+
+// NewEmailVerification creates a verification of email for a user,
+// identified by tokenID and valid for ttl from now.
+func NewEmailVerification(userID int, email Email, tokenID string, now time.Time, ttl time.Duration) *EmailVerification {
+	return &EmailVerification{
+		userID:    userID,
+		email:     email,
+		tokenID:   tokenID,
+		sentAt:    now,
+		expiresAt: now.Add(ttl),
+	}
+}
+
+// UserID returns the ID of the user verifying their email.
+func (v *EmailVerification) UserID() int {
+	return v.userID
+}
+
+// Email returns the address being verified.
+func (v *EmailVerification) Email() Email {
+	return v.email
+}
+
+// TokenID returns the unique ID of the token sent in the verification link.
+func (v *EmailVerification) TokenID() string {
+	return v.tokenID
+}
+
+// SentAt returns when the verification link was sent.
+func (v *EmailVerification) SentAt() time.Time {
+	return v.sentAt
+}
+
+// ExpiresAt returns when the verification link stops working.
+func (v *EmailVerification) ExpiresAt() time.Time {
+	return v.expiresAt
+}
+
+// IsExpired reports whether the verification link has expired at the given time.
+func (v *EmailVerification) IsExpired(now time.Time) bool {
+	return !now.Before(v.expiresAt)
+}
+
+// CanResend reports whether a new link may be sent at the given time,
+// at least interval after the previous one.
+func (v *EmailVerification) CanResend(now time.Time, interval time.Duration) bool {
+	return !now.Before(v.sentAt.Add(interval))
+}
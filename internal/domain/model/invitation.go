@@ -0,0 +1,146 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvitationClosed is returned when answering an invitation that was already answered or has expired
+var ErrInvitationClosed = errors.New("invitation is no longer open")
+
+// InvitationStatus is the state of an invitation.
+type InvitationStatus string
+
+// Invitation states
+const (
+	InvitationPending  InvitationStatus = "pending"  // Sent, waiting for an answer
+	InvitationAccepted InvitationStatus = "accepted" // The invitee joined the team
+	InvitationDeclined InvitationStatus = "declined" // The invitee turned it down
+)
+
+// Invitation asks the owner of an email address to join a team, and its
+// organization, in a given role. The invitee answers with the token sent to
+// the address; only a hash of the token is stored.
+type Invitation struct {
+	id             int
+	organizationID int
+	teamID         int
+	email          Email      // Address the invitation was sent to
+	role           MemberRole // Role the invitee gets in the team
+	tokenHash      string     // Hash of the token sent in the invitation link
+	invitedBy      int        // User who sent the invitation
+	status         InvitationStatus
+	createdAt      time.Time // When the invitation was sent
+	expiresAt      time.Time // When the invitation link stops working
+	answeredAt     time.Time // When the invitation was accepted or declined, zero if it was not
+}
+
+// NewInvitation creates a pending invitation to a team, valid for ttl from now.
+func NewInvitation(organizationID, teamID int, email Email, role MemberRole, tokenHash string, invitedBy int, now time.Time, ttl time.Duration) (*Invitation, error) {
+	if _, err := ParseMemberRole(string(role)); err != nil {
+		return nil, err
+	}
+
+	return &Invitation{
+		organizationID: organizationID,
+		teamID:         teamID,
+		email:          email,
+		role:           role,
+		tokenHash:      tokenHash,
+		invitedBy:      invitedBy,
+		status:         InvitationPending,
+		createdAt:      now,
+		expiresAt:      now.Add(ttl),
+	}, nil
+}
+
+// AssignID sets the identifier of a new invitation when it is first persisted.
+// It fails if the invitation already has an identifier.
+func (i *Invitation) AssignID(id int) error {
+	if i.id != 0 {
+		return fmt.Errorf("invitation already has id %d", i.id)
+	}
+	i.id = id
+	return nil
+}
+
+// ID returns the invitation's identifier.
+func (i *Invitation) ID() int {
+	return i.id
+}
+
+// OrganizationID returns the organization of the team the invitee is asked to join.
+func (i *Invitation) OrganizationID() int {
+	return i.organizationID
+}
+
+// TeamID returns the team the invitee is asked to join.
+func (i *Invitation) TeamID() int {
+	return i.teamID
+}
+
+// Email returns the address the invitation was sent to.
+func (i *Invitation) Email() Email {
+	return i.email
+}
+
+// Role returns the role the invitee gets in the team.
+func (i *Invitation) Role() MemberRole {
+	return i.role
+}
+
+// TokenHash returns the hash of the invitation token.
+func (i *Invitation) TokenHash() string {
+	return i.tokenHash
+}
+
+// InvitedBy returns the user who sent the invitation.
+func (i *Invitation) InvitedBy() int {
+	return i.invitedBy
+}
+
+// Status returns the state of the invitation.
+func (i *Invitation) Status() InvitationStatus {
+	return i.status
+}
+
+// CreatedAt returns when the invitation was sent.
+func (i *Invitation) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+// ExpiresAt returns when the invitation link stops working.
+func (i *Invitation) ExpiresAt() time.Time {
+	return i.expiresAt
+}
+
+// AnsweredAt returns when the invitation was accepted or declined, or the zero time.
+func (i *Invitation) AnsweredAt() time.Time {
+	return i.answeredAt
+}
+
+// IsOpen reports whether the invitation can still be answered at the given time.
+func (i *Invitation) IsOpen(now time.Time) bool {
+	return i.status == InvitationPending && now.Before(i.expiresAt)
+}
+
+// Accept records that the invitee joined the team.
+func (i *Invitation) Accept(now time.Time) error {
+	return i.answer(InvitationAccepted, now)
+}
+
+// Decline records that the invitee turned the invitation down.
+func (i *Invitation) Decline(now time.Time) error {
+	return i.answer(InvitationDeclined, now)
+}
+
+// answer closes an open invitation with the given status
+func (i *Invitation) answer(status InvitationStatus, now time.Time) error {
+	if !i.IsOpen(now) {
+		return ErrInvitationClosed
+	}
+	i.status = status
+	i.answeredAt = now
+	return nil
+}
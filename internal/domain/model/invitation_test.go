@@ -0,0 +1,40 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestInvitationLifecycle(t *testing.T) {
+	now := time.Now()
+	email, _ := NewEmail("jane@example.com")
+
+	if _, err := NewInvitation(1, 1, email, "guest", "hash", 1, now, time.Hour); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("NewInvitation() with unknown role error = %v, want ErrInvalidRole", err)
+	}
+
+	invitation, err := NewInvitation(1, 1, email, RoleAdmin, "hash", 1, now, time.Hour)
+	if err != nil {
+		t.Fatalf("NewInvitation() unexpected error = %v", err)
+	}
+	if !invitation.IsOpen(now) {
+		t.Error("IsOpen() = false for a new invitation")
+	}
+	if invitation.IsOpen(now.Add(time.Hour)) {
+		t.Error("IsOpen() = true at the expiry time")
+	}
+	if err := invitation.Accept(now.Add(time.Hour)); !errors.Is(err, ErrInvitationClosed) {
+		t.Errorf("Accept() after expiry error = %v, want ErrInvitationClosed", err)
+	}
+
+	if err := invitation.Accept(now); err != nil {
+		t.Fatalf("Accept() unexpected error = %v", err)
+	}
+	if invitation.Status() != InvitationAccepted || !invitation.AnsweredAt().Equal(now) {
+		t.Errorf("Status() = %q answered at %v, want accepted at %v", invitation.Status(), invitation.AnsweredAt(), now)
+	}
+	if err := invitation.Decline(now); !errors.Is(err, ErrInvitationClosed) {
+		t.Errorf("Decline() after Accept error = %v, want ErrInvitationClosed", err)
+	}
+}
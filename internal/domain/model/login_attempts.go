@@ -0,0 +1,99 @@
+package model
+
+import "time"
+
+// LockoutPolicy defines how repeated login failures are throttled and locked out.
+type LockoutPolicy struct {
+	MaxFailures     int           // Failures within Window that trigger a lockout
+	Window          time.Duration // Period over which failures are counted
+	LockoutDuration time.Duration // How long a lockout lasts
+	BaseDelay       time.Duration // Delay imposed after the second failure, doubled for each further one
+	MaxDelay        time.Duration // Upper bound for the progressive delay
+}
+
+// LoginAttempts tracks failed login attempts for a single subject,
+// such as an account or a client IP address.
+type LoginAttempts struct {
+	key           string    // Subject identifier (e.g. "account:admin", "ip:10.0.0.1")
+	failures      int       // Failures within the current window
+	windowStart   time.Time // When the current counting window started
+	nextAttemptAt time.Time // Earliest time the next attempt is accepted
+	lockedUntil   time.Time // End of the current lockout, if any
+}
+
+// NewLoginAttempts creates an empty attempt record for the given subject key.
+func NewLoginAttempts(key string) *LoginAttempts {
+	return &LoginAttempts{key: key}
+}
+
+// Key returns the subject identifier.
+func (a *LoginAttempts) Key() string {
+	return a.key
+}
+
+// Failures returns the number of failures in the current window.
+func (a *LoginAttempts) Failures() int {
+	return a.failures
+}
+
+// LockedUntil returns the end of the current lockout, or the zero time.
+func (a *LoginAttempts) LockedUntil() time.Time {
+	return a.lockedUntil
+}
+
+// IsLocked reports whether the subject is locked out at the given time.
+func (a *LoginAttempts) IsLocked(now time.Time) bool {
+	return now.Before(a.lockedUntil)
+}
+
+// BlockedUntil returns when the next attempt will be accepted and whether
+// attempts are currently blocked, either by a lockout or a progressive delay.
+func (a *LoginAttempts) BlockedUntil(now time.Time) (time.Time, bool) {
+	until := a.nextAttemptAt
+	if a.lockedUntil.After(until) {
+		until = a.lockedUntil
+	}
+	return until, now.Before(until)
+}
+
+// IsExpired reports whether the record no longer affects future attempts and can be discarded.
+func (a *LoginAttempts) IsExpired(now time.Time, policy LockoutPolicy) bool {
+	_, blocked := a.BlockedUntil(now)
+	return !blocked && !now.Before(a.windowStart.Add(policy.Window))
+}
+
+// RecordFailure registers a failed attempt and applies the policy.
+// It returns true when this failure triggered a new lockout.
+func (a *LoginAttempts) RecordFailure(now time.Time, policy LockoutPolicy) bool {
+	// Start a new window when the previous one has passed
+	if a.failures == 0 || !now.Before(a.windowStart.Add(policy.Window)) {
+		a.failures = 0
+		a.windowStart = now
+	}
+	a.failures++
+
+	if a.failures >= policy.MaxFailures {
+		a.lockedUntil = now.Add(policy.LockoutDuration)
+		a.failures = 0
+		return true
+	}
+
+	// Progressive delay: no delay after the first failure, then BaseDelay doubling each time
+	if a.failures > 1 && policy.BaseDelay > 0 {
+		delay := policy.BaseDelay << (a.failures - 2)
+		if delay > policy.MaxDelay || delay <= 0 {
+			delay = policy.MaxDelay
+		}
+		a.nextAttemptAt = now.Add(delay)
+	}
+
+	return false
+}
+
+// Unlock clears any lockout, delay and failure count.
+func (a *LoginAttempts) Unlock() {
+	a.failures = 0
+	a.windowStart = time.Time{}
+	a.nextAttemptAt = time.Time{}
+	a.lockedUntil = time.Time{}
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoginAttemptsRecordFailure(t *testing.T) {
+	policy := LockoutPolicy{
+		MaxFailures:     3,
+		Window:          time.Minute,
+		LockoutDuration: 10 * time.Minute,
+		BaseDelay:       time.Second,
+		MaxDelay:        5 * time.Second,
+	}
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	attempts := NewLoginAttempts("account:admin")
+
+	t.Run("First Failure Has No Delay", func(t *testing.T) {
+		if locked := attempts.RecordFailure(now, policy); locked {
+			t.Errorf("RecordFailure() locked = true, want false")
+		}
+		if _, blocked := attempts.BlockedUntil(now); blocked {
+			t.Errorf("BlockedUntil() blocked = true, want false")
+		}
+	})
+
+	t.Run("Second Failure Is Delayed", func(t *testing.T) {
+		attempts.RecordFailure(now, policy)
+		until, blocked := attempts.BlockedUntil(now)
+		if !blocked {
+			t.Fatalf("BlockedUntil() blocked = false, want true")
+		}
+		if want := now.Add(policy.BaseDelay); !until.Equal(want) {
+			t.Errorf("BlockedUntil() until = %v, want %v", until, want)
+		}
+	})
+
+	t.Run("Max Failures Locks Out", func(t *testing.T) {
+		if locked := attempts.RecordFailure(now, policy); !locked {
+			t.Errorf("RecordFailure() locked = false, want true")
+		}
+		if !attempts.IsLocked(now.Add(policy.LockoutDuration - time.Second)) {
+			t.Errorf("IsLocked() = false before lockout ends, want true")
+		}
+		if attempts.IsLocked(now.Add(policy.LockoutDuration)) {
+			t.Errorf("IsLocked() = true after lockout ends, want false")
+		}
+	})
+
+	t.Run("Unlock", func(t *testing.T) {
+		attempts.Unlock()
+		if _, blocked := attempts.BlockedUntil(now); blocked {
+			t.Errorf("BlockedUntil() blocked = true after Unlock(), want false")
+		}
+		if attempts.Failures() != 0 {
+			t.Errorf("Failures() = %v after Unlock(), want 0", attempts.Failures())
+		}
+	})
+
+	t.Run("Window Expiry Resets Count", func(t *testing.T) {
+		attempts.RecordFailure(now, policy)
+		attempts.RecordFailure(now.Add(policy.Window), policy)
+		if attempts.Failures() != 1 {
+			t.Errorf("Failures() = %v after window expired, want 1", attempts.Failures())
+		}
+	})
+}
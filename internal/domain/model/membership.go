@@ -0,0 +1,124 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Membership errors
+var (
+	ErrInvalidRole   = errors.New("invalid member role")         // A role other than owner, admin or member
+	ErrAlreadyMember = errors.New("user is already a member")    // Adding a user who is already a member
+	ErrNotMember     = errors.New("user is not a member")        // Changing or removing a user who is not a member
+	ErrLastOwner     = errors.New("the last owner cannot leave") // Removing or demoting the only owner
+)
+
+// MemberRole is what a member of an organization or team may do in it.
+type MemberRole string
+
+// Member roles, from most to least privileged
+const (
+	RoleOwner  MemberRole = "owner"  // Manages everything, including other owners, and deletes it
+	RoleAdmin  MemberRole = "admin"  // Manages the members who are not owners and invites new ones
+	RoleMember MemberRole = "member" // Sees it and its members
+)
+
+// roleRanks orders the roles, a higher rank granting more
+var roleRanks = map[MemberRole]int{
+	RoleMember: 1,
+	RoleAdmin:  2,
+	RoleOwner:  3,
+}
+
+// ParseMemberRole returns the role named by s, or ErrInvalidRole.
+func ParseMemberRole(s string) (MemberRole, error) {
+	role := MemberRole(s)
+	if _, ok := roleRanks[role]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRole, s)
+	}
+	return role, nil
+}
+
+// AtLeast reports whether the role grants everything other grants.
+func (r MemberRole) AtLeast(other MemberRole) bool {
+	return roleRanks[r] >= roleRanks[other]
+}
+
+// Member is a user's membership of an organization or team.
+type Member struct {
+	UserID   int
+	Role     MemberRole
+	JoinedAt time.Time
+}
+
+// members is the member list of an organization or team. Changes return a
+// new list rather than modifying the slice, so that copies of an aggregate
+// never share a list that is changed afterwards.
+type members []Member
+
+// find returns the membership of a user
+func (m members) find(userID int) (Member, bool) {
+	i := slices.IndexFunc(m, func(member Member) bool { return member.UserID == userID })
+	if i < 0 {
+		return Member{}, false
+	}
+	return m[i], true
+}
+
+// add returns the list with a user added in the given role
+func (m members) add(userID int, role MemberRole, now time.Time) (members, error) {
+	if _, err := ParseMemberRole(string(role)); err != nil {
+		return nil, err
+	}
+	if _, ok := m.find(userID); ok {
+		return nil, ErrAlreadyMember
+	}
+	return append(slices.Clone(m), Member{UserID: userID, Role: role, JoinedAt: now}), nil
+}
+
+// setRole returns the list with the role of a member changed, keeping at least one owner
+func (m members) setRole(userID int, role MemberRole) (members, error) {
+	if _, err := ParseMemberRole(string(role)); err != nil {
+		return nil, err
+	}
+	member, ok := m.find(userID)
+	if !ok {
+		return nil, ErrNotMember
+	}
+	if member.Role == RoleOwner && role != RoleOwner && m.owners() == 1 {
+		return nil, ErrLastOwner
+	}
+
+	changed := slices.Clone(m)
+	for i := range changed {
+		if changed[i].UserID == userID {
+			changed[i].Role = role
+		}
+	}
+	return changed, nil
+}
+
+// remove returns the list without a member, keeping at least one owner
+func (m members) remove(userID int) (members, error) {
+	member, ok := m.find(userID)
+	if !ok {
+		return nil, ErrNotMember
+	}
+	if member.Role == RoleOwner && m.owners() == 1 {
+		return nil, ErrLastOwner
+	}
+	return slices.DeleteFunc(slices.Clone(m), func(member Member) bool { return member.UserID == userID }), nil
+}
+
+// owners returns the number of owners
+func (m members) owners() int {
+	count := 0
+	for _, member := range m {
+		if member.Role == RoleOwner {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// MFA errors
+var (
+	ErrMFAAlreadyEnabled = errors.New("multi-factor authentication is already enabled") // The user already confirmed an authenticator
+	ErrMFANotEnabled     = errors.New("multi-factor authentication is not enabled")     // The user has no confirmed authenticator
+)
+
+// MFACredential is a user's TOTP authenticator (RFC 6238) and recovery codes.
+// It is created unconfirmed when the user starts enrollment, and protects
+// logins only once the user has confirmed it with a code from their app.
+// Recovery codes are kept as hashes and each can be used once.
+type MFACredential struct {
+	userID        int
+	secret        string    // Base32 TOTP secret shared with the authenticator app
+	createdAt     time.Time // When enrollment started
+	confirmedAt   time.Time // When the user confirmed the authenticator, zero while pending
+	lastCounter   int64     // Time step of the last accepted code, so codes can't be replayed
+	recoveryCodes []string  // Hashes of the unused recovery codes
+}
+
+// NewMFACredential creates an unconfirmed credential for a user with a TOTP secret.
+func NewMFACredential(userID int, secret string, now time.Time) *MFACredential {
+	return &MFACredential{
+		userID:    userID,
+		secret:    secret,
+		createdAt: now,
+	}
+}
+
+// RestoreMFACredential recreates a credential from persisted state.
+func RestoreMFACredential(userID int, secret string, createdAt, confirmedAt time.Time, lastCounter int64, recoveryCodes []string) *MFACredential {
+	return &MFACredential{
+		userID:        userID,
+		secret:        secret,
+		createdAt:     createdAt,
+		confirmedAt:   confirmedAt,
+		lastCounter:   lastCounter,
+		recoveryCodes: slices.Clone(recoveryCodes),
+	}
+}
+
+// UserID returns the ID of the user the credential belongs to.
+func (c *MFACredential) UserID() int {
+	return c.userID
+}
+
+// Secret returns the TOTP secret.
+func (c *MFACredential) Secret() string {
+	return c.secret
+}
+
+// CreatedAt returns when enrollment started.
+func (c *MFACredential) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// IsConfirmed reports whether the user confirmed the authenticator, enabling MFA.
+func (c *MFACredential) IsConfirmed() bool {
+	return !c.confirmedAt.IsZero()
+}
+
+// ConfirmedAt returns when the authenticator was confirmed, or the zero time.
+func (c *MFACredential) ConfirmedAt() time.Time {
+	return c.confirmedAt
+}
+
+// LastCounter returns the time step of the last accepted code.
+func (c *MFACredential) LastCounter() int64 {
+	return c.lastCounter
+}
+
+// RecoveryCodes returns the hashes of the unused recovery codes.
+func (c *MFACredential) RecoveryCodes() []string {
+	return slices.Clone(c.recoveryCodes)
+}
+
+// Confirm enables the credential after the user proved possession of the
+// authenticator with the code of the given time step, and sets its recovery codes.
+func (c *MFACredential) Confirm(counter int64, recoveryCodes []string, now time.Time) error {
+	if c.IsConfirmed() {
+		return ErrMFAAlreadyEnabled
+	}
+
+	c.confirmedAt = now
+	c.lastCounter = counter
+	c.recoveryCodes = slices.Clone(recoveryCodes)
+	return nil
+}
+
+// UseCounter accepts a code of the given time step unless a code of the same
+// or a later step was already accepted, which would make it a replay.
+func (c *MFACredential) UseCounter(counter int64) bool {
+	if counter <= c.lastCounter {
+		return false
+	}
+
+	c.lastCounter = counter
+	return true
+}
+
+// UseRecoveryCode consumes the recovery code with the given hash.
+// It reports false if no unused recovery code has that hash.
+func (c *MFACredential) UseRecoveryCode(codeHash string) bool {
+	i := slices.Index(c.recoveryCodes, codeHash)
+	if i < 0 {
+		return false
+	}
+
+	c.recoveryCodes = slices.Delete(c.recoveryCodes, i, i+1)
+	return true
+}
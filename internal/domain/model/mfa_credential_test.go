@@ -0,0 +1,46 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMFACredential(t *testing.T) {
+	now := time.Now()
+	credential := NewMFACredential(1, "SECRET", now)
+	if credential.IsConfirmed() {
+		t.Fatal("IsConfirmed() = true before Confirm")
+	}
+
+	if err := credential.Confirm(100, []string{"a", "b"}, now); err != nil {
+		t.Fatalf("Confirm() unexpected error = %v", err)
+	}
+	if err := credential.Confirm(101, nil, now); !errors.Is(err, ErrMFAAlreadyEnabled) {
+		t.Errorf("Confirm() twice error = %v, want ErrMFAAlreadyEnabled", err)
+	}
+
+	t.Run("Counters", func(t *testing.T) {
+		if credential.UseCounter(100) {
+			t.Error("UseCounter() accepted the step used to confirm")
+		}
+		if !credential.UseCounter(101) {
+			t.Error("UseCounter() rejected a new step")
+		}
+		if credential.UseCounter(101) || credential.UseCounter(99) {
+			t.Error("UseCounter() accepted a replayed or older step")
+		}
+	})
+
+	t.Run("Recovery Codes", func(t *testing.T) {
+		if !credential.UseRecoveryCode("a") {
+			t.Error("UseRecoveryCode() rejected an unused code")
+		}
+		if credential.UseRecoveryCode("a") || credential.UseRecoveryCode("c") {
+			t.Error("UseRecoveryCode() accepted a used or unknown code")
+		}
+		if codes := credential.RecoveryCodes(); len(codes) != 1 || codes[0] != "b" {
+			t.Errorf("RecoveryCodes() = %v, want [b]", codes)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewOAuthClient(t *testing.T) {
+	now := time.Now()
+
+	if _, err := NewOAuthClient(DefaultTenantID, " ", "client_1", "hash", []string{ScopeUsersRead}, false, "admin", now); !errors.Is(err, ErrInvalidOAuthClientData) {
+		t.Errorf("NewOAuthClient() blank name error = %v, want ErrInvalidOAuthClientData", err)
+	}
+	if _, err := NewOAuthClient(DefaultTenantID, "reports", "client_1", "hash", nil, false, "admin", now); !errors.Is(err, ErrInvalidOAuthClientData) {
+		t.Errorf("NewOAuthClient() no scopes error = %v, want ErrInvalidOAuthClientData", err)
+	}
+	_, err := NewOAuthClient(DefaultTenantID, "reports", "client_1", "hash", []string{"users:delete"}, false, "admin", now)
+	if !errors.Is(err, ErrInvalidScope) || !strings.HasSuffix(err.Error(), "must be one of "+strings.Join(ClientScopes, ", ")) {
+		t.Errorf("NewOAuthClient() unknown scope error = %v, want ErrInvalidScope naming the client scopes", err)
+	}
+	if _, err := NewOAuthClient(DefaultTenantID, "gateway", "client_1", "hash", []string{ScopeTokensIntrospect}, false, "admin", now); err != nil {
+		t.Errorf("NewOAuthClient() introspection scope unexpected error = %v", err)
+	}
+
+	client, err := NewOAuthClient(DefaultTenantID, "reports", "client_1", "hash", []string{ScopeUsersWrite, ScopeUsersRead, ScopeUsersRead}, false, "admin", now)
+	if err != nil {
+		t.Fatalf("NewOAuthClient() unexpected error = %v", err)
+	}
+	if got := client.Scopes(); len(got) != 2 || got[0] != ScopeUsersRead || got[1] != ScopeUsersWrite {
+		t.Errorf("Scopes() = %v, want sorted scopes without duplicates", got)
+	}
+}
+
+func TestOAuthRefreshToken(t *testing.T) {
+	now := time.Now()
+	token := NewOAuthRefreshToken("hash", "client_1", []string{ScopeUsersRead}, now, time.Hour)
+
+	if !token.IsActive(now) {
+		t.Error("IsActive() = false for a new token")
+	}
+	if token.IsActive(now.Add(time.Hour)) {
+		t.Error("IsActive() = true at the expiry time")
+	}
+
+	token.Revoke(now)
+	if token.IsActive(now) {
+		t.Error("IsActive() = true after Revoke")
+	}
+}
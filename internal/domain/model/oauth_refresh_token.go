@@ -0,0 +1,67 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// OAuthRefreshToken lets an OAuth client obtain a new access token without
+// sending its scopes again. Only a hash of the token is stored. A refresh token
+// is used once: the token endpoint revokes it and issues a new one.
+type OAuthRefreshToken struct {
+	tokenHash string    // Hash of the token handed to the client
+	clientID  string    // Client the token was issued to
+	scopes    []string  // Scopes granted to the access tokens it refreshes
+	issuedAt  time.Time // When the token was issued
+	expiresAt time.Time // When the token stops working
+	revokedAt time.Time // When the token was used or revoked, zero if it is not
+}
+
+// NewOAuthRefreshToken creates a refresh token for a client, identified by the
+// hash of the token and valid for ttl from now.
+func NewOAuthRefreshToken(tokenHash, clientID string, scopes []string, now time.Time, ttl time.Duration) *OAuthRefreshToken {
+	return &OAuthRefreshToken{
+		tokenHash: tokenHash,
+		clientID:  clientID,
+		scopes:    slices.Clone(scopes),
+		issuedAt:  now,
+		expiresAt: now.Add(ttl),
+	}
+}
+
+// TokenHash returns the hash of the token.
+func (t *OAuthRefreshToken) TokenHash() string {
+	return t.tokenHash
+}
+
+// ClientID returns the client the token was issued to.
+func (t *OAuthRefreshToken) ClientID() string {
+	return t.clientID
+}
+
+// Scopes returns the scopes granted to the access tokens the token refreshes.
+func (t *OAuthRefreshToken) Scopes() []string {
+	return slices.Clone(t.scopes)
+}
+
+// IssuedAt returns when the token was issued.
+func (t *OAuthRefreshToken) IssuedAt() time.Time {
+	return t.issuedAt
+}
+
+// ExpiresAt returns when the token stops working.
+func (t *OAuthRefreshToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+// IsActive reports whether the token can be used at the given time.
+func (t *OAuthRefreshToken) IsActive(now time.Time) bool {
+	return t.revokedAt.IsZero() && now.Before(t.expiresAt)
+}
+
+// Revoke disables the token. Revoking a revoked token has no effect.
+func (t *OAuthRefreshToken) Revoke(now time.Time) {
+	if t.revokedAt.IsZero() {
+		t.revokedAt = now
+	}
+}
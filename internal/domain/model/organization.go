@@ -0,0 +1,145 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxOrganizationNameLength is the maximum length of organization and team names in characters
+const MaxOrganizationNameLength = 100
+
+// ErrInvalidOrganizationData is returned when an organization or team has no name or a name too long
+var ErrInvalidOrganizationData = errors.New("invalid organization data")
+
+// Organization is a group of users of a tenant, such as a company or a
+// department, which organizes its members into teams. Every member has a role
+// in the organization; its owners manage it and there is always at least one.
+type Organization struct {
+	id        int
+	tenantID  string // Tenant the organization belongs to
+	name      string
+	members   members // Members with their role in the organization
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewOrganization creates an organization of a tenant, owned by the user creating it.
+func NewOrganization(tenantID, name string, ownerID int, now time.Time) (*Organization, error) {
+	name, err := organizationName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Organization{
+		tenantID:  tenantID,
+		name:      name,
+		members:   members{{UserID: ownerID, Role: RoleOwner, JoinedAt: now}},
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// AssignID sets the identifier of a new organization when it is first persisted.
+// It fails if the organization already has an identifier.
+func (o *Organization) AssignID(id int) error {
+	if o.id != 0 {
+		return fmt.Errorf("organization already has id %d", o.id)
+	}
+	o.id = id
+	return nil
+}
+
+// ID returns the organization's identifier.
+func (o *Organization) ID() int {
+	return o.id
+}
+
+// TenantID returns the tenant the organization belongs to.
+func (o *Organization) TenantID() string {
+	return o.tenantID
+}
+
+// Name returns the organization's name.
+func (o *Organization) Name() string {
+	return o.name
+}
+
+// Rename changes the organization's name.
+func (o *Organization) Rename(name string, now time.Time) error {
+	name, err := organizationName(name)
+	if err != nil {
+		return err
+	}
+	o.name = name
+	o.updatedAt = now
+	return nil
+}
+
+// Members returns the organization's members in the order they joined.
+func (o *Organization) Members() []Member {
+	return slices.Clone(o.members)
+}
+
+// Member returns the membership of a user, or false if they are not a member.
+func (o *Organization) Member(userID int) (Member, bool) {
+	return o.members.find(userID)
+}
+
+// AddMember adds a user to the organization in the given role.
+func (o *Organization) AddMember(userID int, role MemberRole, now time.Time) error {
+	changed, err := o.members.add(userID, role, now)
+	if err != nil {
+		return err
+	}
+	o.members = changed
+	o.updatedAt = now
+	return nil
+}
+
+// ChangeRole changes the role of a member. The last owner cannot be demoted.
+func (o *Organization) ChangeRole(userID int, role MemberRole, now time.Time) error {
+	changed, err := o.members.setRole(userID, role)
+	if err != nil {
+		return err
+	}
+	o.members = changed
+	o.updatedAt = now
+	return nil
+}
+
+// RemoveMember removes a member. The last owner cannot be removed.
+func (o *Organization) RemoveMember(userID int, now time.Time) error {
+	changed, err := o.members.remove(userID)
+	if err != nil {
+		return err
+	}
+	o.members = changed
+	o.updatedAt = now
+	return nil
+}
+
+// CreatedAt returns when the organization was created.
+func (o *Organization) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+// UpdatedAt returns when the organization or its members last changed.
+func (o *Organization) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
+// organizationName validates and normalizes the name of an organization or team
+func organizationName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: name is required", ErrInvalidOrganizationData)
+	}
+	if utf8.RuneCountInString(name) > MaxOrganizationNameLength {
+		return "", fmt.Errorf("%w: name must be at most %d characters", ErrInvalidOrganizationData, MaxOrganizationNameLength)
+	}
+	return name, nil
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewOrganization(t *testing.T) {
+	tests := []struct {
+		name    string
+		orgName string
+		wantErr error
+	}{
+		{"Valid", "Acme", nil},
+		{"Blank Name", "  ", ErrInvalidOrganizationData},
+		{"Name Too Long", strings.Repeat("a", MaxOrganizationNameLength+1), ErrInvalidOrganizationData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organization, err := NewOrganization(DefaultTenantID, tt.orgName, 1, time.Now())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewOrganization() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if member, ok := organization.Member(1); !ok || member.Role != RoleOwner {
+				t.Errorf("Member(creator) = %v, %v, want owner", member, ok)
+			}
+		})
+	}
+}
+
+func TestOrganizationMembers(t *testing.T) {
+	now := time.Now()
+	organization, err := NewOrganization(DefaultTenantID, "Acme", 1, now)
+	if err != nil {
+		t.Fatalf("NewOrganization() unexpected error = %v", err)
+	}
+
+	if err := organization.AddMember(2, RoleMember, now); err != nil {
+		t.Fatalf("AddMember() unexpected error = %v", err)
+	}
+	if err := organization.AddMember(2, RoleAdmin, now); !errors.Is(err, ErrAlreadyMember) {
+		t.Errorf("AddMember() twice error = %v, want ErrAlreadyMember", err)
+	}
+	if err := organization.AddMember(3, "guest", now); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("AddMember() with unknown role error = %v, want ErrInvalidRole", err)
+	}
+
+	// The only owner can neither be demoted nor leave
+	if err := organization.ChangeRole(1, RoleAdmin, now); !errors.Is(err, ErrLastOwner) {
+		t.Errorf("ChangeRole() of last owner error = %v, want ErrLastOwner", err)
+	}
+	if err := organization.RemoveMember(1, now); !errors.Is(err, ErrLastOwner) {
+		t.Errorf("RemoveMember() of last owner error = %v, want ErrLastOwner", err)
+	}
+
+	// Once another member is owner, the first one may leave
+	if err := organization.ChangeRole(2, RoleOwner, now); err != nil {
+		t.Fatalf("ChangeRole() unexpected error = %v", err)
+	}
+	if err := organization.RemoveMember(1, now); err != nil {
+		t.Fatalf("RemoveMember() unexpected error = %v", err)
+	}
+	if _, ok := organization.Member(1); ok {
+		t.Error("Member() found a removed member")
+	}
+	if err := organization.RemoveMember(1, now); !errors.Is(err, ErrNotMember) {
+		t.Errorf("RemoveMember() twice error = %v, want ErrNotMember", err)
+	}
+}
+
+func TestOrganizationCopiesDoNotShareMembers(t *testing.T) {
+	now := time.Now()
+	organization, err := NewOrganization(DefaultTenantID, "Acme", 1, now)
+	if err != nil {
+		t.Fatalf("NewOrganization() unexpected error = %v", err)
+	}
+
+	copied := *organization
+	if err := copied.AddMember(2, RoleMember, now); err != nil {
+		t.Fatalf("AddMember() unexpected error = %v", err)
+	}
+	if err := copied.ChangeRole(1, RoleOwner, now); err != nil {
+		t.Fatalf("ChangeRole() unexpected error = %v", err)
+	}
+	if len(organization.Members()) != 1 {
+		t.Errorf("Members() of the original = %v, want only the owner", organization.Members())
+	}
+}
+
+func TestMemberRoleAtLeast(t *testing.T) {
+	tests := []struct {
+		role, other MemberRole
+		want        bool
+	}{
+		{RoleOwner, RoleAdmin, true},
+		{RoleAdmin, RoleAdmin, true},
+		{RoleMember, RoleAdmin, false},
+		{"", RoleMember, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.AtLeast(tt.other); got != tt.want {
+			t.Errorf("%q.AtLeast(%q) = %v, want %v", tt.role, tt.other, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxPasswordBytes is the longest password that can be hashed.
+// bcrypt only uses the first 72 bytes, so longer passwords are rejected
+// rather than silently truncated.
+const MaxPasswordBytes = 72
+
+// Password rules, reported in PasswordViolation.Rule
+const (
+	PasswordRuleMinLength    = "min"           // Shorter than MinLength characters
+	PasswordRuleMaxLength    = "max"           // Longer than MaxLength characters
+	PasswordRuleMaxBytes     = "max_bytes"     // Longer than MaxPasswordBytes bytes
+	PasswordRuleCharClasses  = "char_classes"  // Fewer than MinCharClasses character classes
+	PasswordRulePersonalInfo = "personal_info" // Contains the user's name or email address
+	PasswordRuleBreached     = "breached"      // Appears in a list of breached passwords
+)
+
+// minPersonalInfoLength is the shortest name or email part checked for in passwords,
+// so that short names such as "Al" don't rule out ordinary passwords
+const minPersonalInfoLength = 3
+
+// PasswordPolicy defines the rules a new password must satisfy.
+type PasswordPolicy struct {
+	MinLength            int  // Minimum length in characters
+	MaxLength            int  // Maximum length in characters, at most MaxPasswordBytes bytes
+	MinCharClasses       int  // Minimum number of classes among lowercase, uppercase, digits and symbols
+	DisallowPersonalInfo bool // Reject passwords containing the user's name or email address
+}
+
+// PasswordViolation is a rule a password fails to satisfy.
+type PasswordViolation struct {
+	Rule  string // One of the PasswordRule constants
+	Limit int    // The policy limit involved, e.g. the minimum length
+}
+
+// Check returns every rule of the policy that password violates for user.
+// The user may be nil when the password is not tied to a user yet.
+func (p PasswordPolicy) Check(password string, user *User) []PasswordViolation {
+	var violations []PasswordViolation
+
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleMinLength, Limit: p.MinLength})
+	}
+	if length > p.MaxLength {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleMaxLength, Limit: p.MaxLength})
+	}
+	if len(password) > MaxPasswordBytes {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleMaxBytes, Limit: MaxPasswordBytes})
+	}
+
+	if charClasses(password) < p.MinCharClasses {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleCharClasses, Limit: p.MinCharClasses})
+	}
+
+	if p.DisallowPersonalInfo && user != nil && containsPersonalInfo(password, user) {
+		violations = append(violations, PasswordViolation{Rule: PasswordRulePersonalInfo})
+	}
+
+	return violations
+}
+
+// charClasses counts the classes of characters used in password
+func charClasses(password string) int {
+	var lower, upper, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+	return lower + upper + digit + symbol
+}
+
+// containsPersonalInfo reports whether password contains the user's email
+// address, its local part, or any part of the user's name or display name
+func containsPersonalInfo(password string, user *User) bool {
+	password = strings.ToLower(password)
+
+	email := user.Email().String()
+	parts := []string{email}
+	if local, _, ok := strings.Cut(email, "@"); ok {
+		parts = append(parts, local)
+	}
+	parts = append(parts, strings.Fields(strings.ToLower(user.Name()))...)
+	parts = append(parts, strings.Fields(strings.ToLower(user.DisplayName()))...)
+
+	for _, part := range parts {
+		if utf8.RuneCountInString(part) >= minPersonalInfoLength && strings.Contains(password, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:            8,
+		MaxLength:            20,
+		MinCharClasses:       2,
+		DisallowPersonalInfo: true,
+	}
+	user, _ := NewUserWithID(1, "John Doe", "jdoe@example.com", 30)
+	shortNames, _ := NewUserWithID(2, "Al Li", "al@example.com", 30)
+
+	tests := []struct {
+		name     string
+		password string
+		user     *User
+		want     []string
+	}{
+		{"Valid", "correct-horse-42", user, nil},
+		{"Too Short", "ab1", user, []string{PasswordRuleMinLength}},
+		{"Too Long", strings.Repeat("ab1", 7), user, []string{PasswordRuleMaxLength}},
+		{"Too Many Bytes", strings.Repeat("😀", 19), nil, []string{PasswordRuleMaxBytes, PasswordRuleCharClasses}},
+		{"Too Long And Too Many Bytes", strings.Repeat("😀a", 21), user, []string{PasswordRuleMaxLength, PasswordRuleMaxBytes}},
+		{"Single Class", "abcdefghij", user, []string{PasswordRuleCharClasses}},
+		{"Contains Name", "Johnny-2024", user, []string{PasswordRulePersonalInfo}},
+		{"Contains Email Local Part", "xJDOE!1234", user, []string{PasswordRulePersonalInfo}},
+		{"Contains Name Without User", "Johnny-2024", nil, nil},
+		{"Contains Last Name", "doe-is-fine", user, []string{PasswordRulePersonalInfo}},
+		{"Short Name Parts Ignored", "Always-Lively1", shortNames, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, v := range policy.Check(tt.password, tt.user) {
+				got = append(got, v.Rule)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check(%q) = %v, want %v", tt.password, got, tt.want)
+			}
+		})
+	}
+}
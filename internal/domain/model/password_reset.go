@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+// PasswordReset is an outstanding request to reset a user's password.
+// Only a hash of the reset token is kept, so the stored record cannot be used
+// to reset the password. Each user has at most one; a new request replaces it.
+type PasswordReset struct {
+	tenantID  string // Tenant of the user
+	userID    int
+	tokenHash string    // Hash of the token sent in the reset link
+	createdAt time.Time // When the reset was requested
+	expiresAt time.Time // When the reset link stops working
+}
+
+// NewPasswordReset creates a password reset for a user of a tenant, identified
+// by the hash of its token and valid for ttl from now.
+func NewPasswordReset(tenantID string, userID int, tokenHash string, now time.Time, ttl time.Duration) *PasswordReset {
+	return &PasswordReset{
+		tenantID:  tenantID,
+		userID:    userID,
+		tokenHash: tokenHash,
+		createdAt: now,
+		expiresAt: now.Add(ttl),
+	}
+}
+
+// TenantID returns the tenant of the user resetting their password.
+func (r *PasswordReset) TenantID() string {
+	return r.tenantID
+}
+
+// UserID returns the ID of the user resetting their password.
+func (r *PasswordReset) UserID() int {
+	return r.userID
+}
+
+// TokenHash returns the hash of the reset token.
+func (r *PasswordReset) TokenHash() string {
+	return r.tokenHash
+}
+
+// CreatedAt returns when the reset was requested.
+func (r *PasswordReset) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// ExpiresAt returns when the reset link stops working.
+func (r *PasswordReset) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+// IsExpired reports whether the reset link has expired at the given time.
+func (r *PasswordReset) IsExpired(now time.Time) bool {
+	return !now.Before(r.expiresAt)
+}
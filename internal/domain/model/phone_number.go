@@ -0,0 +1,39 @@
+package model
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// e164Regex matches a phone number in E.164 format: a plus sign followed by up to 15 digits
+var e164Regex = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// phoneSeparators are the formatting characters removed before validating a phone number
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+// PhoneNumber is a validated phone number value object in E.164 format (e.g. +905551234567).
+// The zero value represents an unset phone number.
+type PhoneNumber struct {
+	value string
+}
+
+// NewPhoneNumber validates a phone number, ignoring common formatting characters
+// such as spaces, dashes and parentheses. The number must include its country code.
+func NewPhoneNumber(number string) (PhoneNumber, error) {
+	number = phoneSeparators.Replace(strings.TrimSpace(number))
+	if !e164Regex.MatchString(number) {
+		return PhoneNumber{}, errors.New("phone number must be in E.164 format, e.g. +14155552671")
+	}
+	return PhoneNumber{value: number}, nil
+}
+
+// String returns the phone number in E.164 format, or an empty string if unset.
+func (p PhoneNumber) String() string {
+	return p.value
+}
+
+// IsZero reports whether the phone number is unset.
+func (p PhoneNumber) IsZero() bool {
+	return p.value == ""
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Scopes grant access to parts of the API. Access tokens carry them in their
+// "scope" claim, and API keys and OAuth clients are granted them explicitly.
+const (
+	ScopeUsersRead  = "users:read"  // List and view users
+	ScopeUsersWrite = "users:write" // Create, update, delete and transition users
+)
+
+// ScopeTokensIntrospect lets an OAuth client introspect the access tokens of
+// users. It is only granted to OAuth clients.
+const ScopeTokensIntrospect = "tokens:introspect"
+
+// Scopes lists every scope that can be granted
+var Scopes = []string{ScopeUsersRead, ScopeUsersWrite}
+
+// ClientScopes lists every scope that can be granted to an OAuth client
+var ClientScopes = []string{ScopeTokensIntrospect, ScopeUsersRead, ScopeUsersWrite}
+
+// Scope errors
+var (
+	ErrInvalidScope    = errors.New("unknown scope")               // Granting a scope that doesn't exist, see ValidateScopes and ValidateClientScopes
+	ErrScopeNotGranted = errors.New("requested scope not granted") // Requesting a scope beyond those available
+)
+
+// ValidateScopes checks that every scope is one of Scopes.
+func ValidateScopes(scopes []string) error {
+	return validateScopes(scopes, Scopes)
+}
+
+// ValidateClientScopes checks that every scope is one of ClientScopes, which can be granted to an OAuth client.
+func ValidateClientScopes(scopes []string) error {
+	return validateScopes(scopes, ClientScopes)
+}
+
+// validateScopes checks that every scope is among valid, naming them in the error
+func validateScopes(scopes, valid []string) error {
+	for _, scope := range scopes {
+		if !slices.Contains(valid, scope) {
+			return fmt.Errorf("%w %q, must be one of %s", ErrInvalidScope, scope, strings.Join(valid, ", "))
+		}
+	}
+	return nil
+}
+
+// NarrowScopes returns the scopes requested in a space-separated scope
+// parameter, sorted and without duplicates. Every requested scope must be
+// among available; if none are requested, all available scopes are returned.
+func NarrowScopes(available []string, scope string) ([]string, error) {
+	requested := strings.Fields(scope)
+	if len(requested) == 0 {
+		return slices.Clone(available), nil
+	}
+
+	for _, s := range requested {
+		if !slices.Contains(available, s) {
+			return nil, fmt.Errorf("%w: %q", ErrScopeNotGranted, s)
+		}
+	}
+	slices.Sort(requested)
+	return slices.Compact(requested), nil
+}
@@ -0,0 +1,170 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+)
+
+// maxUserAgentLength bounds the stored user agent, which clients choose freely
+const maxUserAgentLength = 255
+
+// Session is a user's sign-in on one device. It is created when the user
+// signs in and holds the refresh token the device uses to obtain new access
+// tokens; only a hash of the token is stored, and it is replaced on every use.
+// Access tokens name their session, so revoking a session signs the device out.
+type Session struct {
+	id               int
+	tenantID         string // Tenant the user signed in to
+	userID           int
+	username         string    // Username the user signed in with
+	admin            bool      // Whether the user signed in as an administrator
+	amr              []string  // Authentication methods used to sign in
+	scopes           []string  // Scopes granted to the session's access tokens
+	refreshTokenHash string    // Hash of the current refresh token
+	ip               string    // Client IP address last seen
+	userAgent        string    // Client user agent last seen
+	createdAt        time.Time // When the user signed in
+	lastSeenAt       time.Time // When the session was last used
+	expiresAt        time.Time // When the session ends, whatever its use
+	revokedAt        time.Time // When the session was revoked, zero if it is not
+}
+
+// SessionGrant describes what a session's access tokens grant.
+type SessionGrant struct {
+	TenantID string
+	UserID   int
+	Username string
+	Admin    bool
+	AMR      []string // Authentication methods used to sign in
+	Scopes   []string // Granted scopes
+}
+
+// NewSession creates a session for a sign-in from the given client, valid for ttl from now.
+func NewSession(grant SessionGrant, refreshTokenHash, ip, userAgent string, now time.Time, ttl time.Duration) *Session {
+	return &Session{
+		tenantID:         grant.TenantID,
+		userID:           grant.UserID,
+		username:         grant.Username,
+		admin:            grant.Admin,
+		amr:              slices.Clone(grant.AMR),
+		scopes:           slices.Clone(grant.Scopes),
+		refreshTokenHash: refreshTokenHash,
+		ip:               ip,
+		userAgent:        truncateUserAgent(userAgent),
+		createdAt:        now,
+		lastSeenAt:       now,
+		expiresAt:        now.Add(ttl),
+	}
+}
+
+// AssignID sets the identifier of a new session when it is first persisted.
+// It fails if the session already has an identifier.
+func (s *Session) AssignID(id int) error {
+	if s.id != 0 {
+		return fmt.Errorf("session already has id %d", s.id)
+	}
+	s.id = id
+	return nil
+}
+
+// ID returns the session's identifier.
+func (s *Session) ID() int {
+	return s.id
+}
+
+// TenantID returns the tenant the user signed in to.
+func (s *Session) TenantID() string {
+	return s.tenantID
+}
+
+// UserID returns the signed-in user.
+func (s *Session) UserID() int {
+	return s.userID
+}
+
+// Grant returns what the session's access tokens grant.
+func (s *Session) Grant() SessionGrant {
+	return SessionGrant{
+		TenantID: s.tenantID,
+		UserID:   s.userID,
+		Username: s.username,
+		Admin:    s.admin,
+		AMR:      slices.Clone(s.amr),
+		Scopes:   slices.Clone(s.scopes),
+	}
+}
+
+// RefreshTokenHash returns the hash of the current refresh token.
+func (s *Session) RefreshTokenHash() string {
+	return s.refreshTokenHash
+}
+
+// IP returns the client IP address last seen.
+func (s *Session) IP() string {
+	return s.ip
+}
+
+// UserAgent returns the client user agent last seen.
+func (s *Session) UserAgent() string {
+	return s.userAgent
+}
+
+// CreatedAt returns when the user signed in.
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// LastSeenAt returns when the session was last used.
+func (s *Session) LastSeenAt() time.Time {
+	return s.lastSeenAt
+}
+
+// ExpiresAt returns when the session ends.
+func (s *Session) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+// RevokedAt returns when the session was revoked, or the zero time.
+func (s *Session) RevokedAt() time.Time {
+	return s.revokedAt
+}
+
+// IsActive reports whether the session can be used at the given time.
+func (s *Session) IsActive(now time.Time) bool {
+	return s.revokedAt.IsZero() && now.Before(s.expiresAt)
+}
+
+// Touch records a use of the session from the given client.
+func (s *Session) Touch(now time.Time, ip, userAgent string) {
+	s.lastSeenAt = now
+	s.ip = ip
+	s.userAgent = truncateUserAgent(userAgent)
+}
+
+// RotateRefreshToken replaces the refresh token after it was used by the given client.
+func (s *Session) RotateRefreshToken(refreshTokenHash string, now time.Time, ip, userAgent string) {
+	s.refreshTokenHash = refreshTokenHash
+	s.Touch(now, ip, userAgent)
+}
+
+// Revoke ends the session. Revoking a revoked session has no effect.
+func (s *Session) Revoke(now time.Time) {
+	if s.revokedAt.IsZero() {
+		s.revokedAt = now
+	}
+}
+
+// truncateUserAgent returns userAgent limited to maxUserAgentLength bytes,
+// without splitting a multi-byte character.
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) <= maxUserAgentLength {
+		return userAgent
+	}
+	cut := maxUserAgentLength
+	for cut > 0 && !utf8.RuneStart(userAgent[cut]) {
+		cut--
+	}
+	return userAgent[:cut]
+}
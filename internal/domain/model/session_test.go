@@ -0,0 +1,54 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestSessionLifecycle(t *testing.T) {
+	now := time.Now()
+	grant := SessionGrant{UserID: 2, Username: "jane", AMR: []string{"pwd"}, Scopes: []string{ScopeUsersRead}}
+	session := NewSession(grant, "hash1", "203.0.113.7", "curl", now, time.Hour)
+
+	if !session.IsActive(now) {
+		t.Error("IsActive() = false for a new session")
+	}
+	if session.IsActive(now.Add(time.Hour)) {
+		t.Error("IsActive() = true at the expiry time")
+	}
+
+	grant.Scopes[0] = ScopeUsersWrite
+	if got := session.Grant().Scopes; got[0] != ScopeUsersRead {
+		t.Errorf("Grant().Scopes = %v, want the scopes at creation", got)
+	}
+
+	later := now.Add(time.Minute)
+	session.RotateRefreshToken("hash2", later, "198.51.100.1", "browser")
+	if session.RefreshTokenHash() != "hash2" || session.IP() != "198.51.100.1" || !session.LastSeenAt().Equal(later) {
+		t.Errorf("RotateRefreshToken() left hash %q, ip %q, last seen %v", session.RefreshTokenHash(), session.IP(), session.LastSeenAt())
+	}
+	if !session.CreatedAt().Equal(now) || !session.ExpiresAt().Equal(now.Add(time.Hour)) {
+		t.Error("RotateRefreshToken() changed the session's lifetime")
+	}
+
+	session.Revoke(later)
+	session.Revoke(later.Add(time.Minute))
+	if session.IsActive(later) {
+		t.Error("IsActive() = true after Revoke")
+	}
+	if !session.RevokedAt().Equal(later) {
+		t.Errorf("RevokedAt() = %v, want the first revocation %v", session.RevokedAt(), later)
+	}
+}
+
+func TestSessionUserAgentTruncated(t *testing.T) {
+	userAgent := strings.Repeat("a", maxUserAgentLength-1) + "é"
+	session := NewSession(SessionGrant{UserID: 1}, "hash", "", userAgent, time.Now(), time.Hour)
+
+	got := session.UserAgent()
+	if len(got) != maxUserAgentLength-1 || !utf8.ValidString(got) {
+		t.Errorf("UserAgent() has length %d, want %d without a split character", len(got), maxUserAgentLength-1)
+	}
+}
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Team is a group of members of an organization. Each member of a team has a
+// role in it, independent of their role in the organization; the team's
+// owners manage it and there is always at least one.
+type Team struct {
+	id             int
+	organizationID int // Organization the team belongs to
+	name           string
+	members        members // Members with their role in the team
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// NewTeam creates a team of an organization, owned by the user creating it.
+func NewTeam(organizationID int, name string, ownerID int, now time.Time) (*Team, error) {
+	name, err := organizationName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Team{
+		organizationID: organizationID,
+		name:           name,
+		members:        members{{UserID: ownerID, Role: RoleOwner, JoinedAt: now}},
+		createdAt:      now,
+		updatedAt:      now,
+	}, nil
+}
+
+// AssignID sets the identifier of a new team when it is first persisted.
+// It fails if the team already has an identifier.
+func (t *Team) AssignID(id int) error {
+	if t.id != 0 {
+		return fmt.Errorf("team already has id %d", t.id)
+	}
+	t.id = id
+	return nil
+}
+
+// ID returns the team's identifier.
+func (t *Team) ID() int {
+	return t.id
+}
+
+// OrganizationID returns the organization the team belongs to.
+func (t *Team) OrganizationID() int {
+	return t.organizationID
+}
+
+// Name returns the team's name.
+func (t *Team) Name() string {
+	return t.name
+}
+
+// Rename changes the team's name.
+func (t *Team) Rename(name string, now time.Time) error {
+	name, err := organizationName(name)
+	if err != nil {
+		return err
+	}
+	t.name = name
+	t.updatedAt = now
+	return nil
+}
+
+// Members returns the team's members in the order they joined.
+func (t *Team) Members() []Member {
+	return slices.Clone(t.members)
+}
+
+// Member returns the membership of a user, or false if they are not a member.
+func (t *Team) Member(userID int) (Member, bool) {
+	return t.members.find(userID)
+}
+
+// AddMember adds a user to the team in the given role.
+func (t *Team) AddMember(userID int, role MemberRole, now time.Time) error {
+	changed, err := t.members.add(userID, role, now)
+	if err != nil {
+		return err
+	}
+	t.members = changed
+	t.updatedAt = now
+	return nil
+}
+
+// ChangeRole changes the role of a member. The last owner cannot be demoted.
+func (t *Team) ChangeRole(userID int, role MemberRole, now time.Time) error {
+	changed, err := t.members.setRole(userID, role)
+	if err != nil {
+		return err
+	}
+	t.members = changed
+	t.updatedAt = now
+	return nil
+}
+
+// RemoveMember removes a member. The last owner cannot be removed.
+func (t *Team) RemoveMember(userID int, now time.Time) error {
+	changed, err := t.members.remove(userID)
+	if err != nil {
+		return err
+	}
+	t.members = changed
+	t.updatedAt = now
+	return nil
+}
+
+// CreatedAt returns when the team was created.
+func (t *Team) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt returns when the team or its members last changed.
+func (t *Team) UpdatedAt() time.Time {
+	return t.updatedAt
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultTenantID is the tenant of requests that name none
+const DefaultTenantID = "default"
+
+// Tenant errors
+var (
+	ErrInvalidTenantData = errors.New("invalid tenant data")  // A new tenant has a malformed ID or no name
+	ErrNoTenant          = errors.New("no tenant in context") // A tenant-scoped operation was called without a tenant
+)
+
+// tenantIDPattern matches a DNS label, so tenant IDs can be used as subdomains
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// Tenant is a customer organization hosted on the deployment. Every user
+// belongs to exactly one tenant, and users of a tenant are invisible to others.
+// Tenants are identified by a short lowercase ID, also used as their subdomain.
+type Tenant struct {
+	id        string
+	name      string
+	createdAt time.Time
+}
+
+// NewTenant creates a tenant. The ID must be a valid DNS label in lowercase.
+func NewTenant(id, name string, now time.Time) (*Tenant, error) {
+	if !tenantIDPattern.MatchString(id) {
+		return nil, fmt.Errorf("%w: ID must be 1 to 63 lowercase letters, digits or hyphens", ErrInvalidTenantData)
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidTenantData)
+	}
+
+	return &Tenant{
+		id:        id,
+		name:      name,
+		createdAt: now,
+	}, nil
+}
+
+// ID returns the tenant's identifier.
+func (t *Tenant) ID() string {
+	return t.id
+}
+
+// Name returns the tenant's display name.
+func (t *Tenant) Name() string {
+	return t.name
+}
+
+// CreatedAt returns when the tenant was created.
+func (t *Tenant) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// tenantKey is the context key of the request tenant
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx scoped to the tenant with the given ID.
+// Tenant-scoped repositories only see the data of the tenant in their context.
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+// TenantFromContext returns the ID of the tenant ctx is scoped to,
+// or ErrNoTenant if it is not scoped to any.
+func TenantFromContext(ctx context.Context) (string, error) {
+	tenantID, ok := ctx.Value(tenantKey{}).(string)
+	if !ok || tenantID == "" {
+		return "", ErrNoTenant
+	}
+	return tenantID, nil
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewTenant(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		tenantName string
+		wantErr    error
+	}{
+		{"Valid", "acme-corp", "Acme Corporation", nil},
+		{"Uppercase ID", "Acme", "Acme Corporation", ErrInvalidTenantData},
+		{"Leading Hyphen", "-acme", "Acme Corporation", ErrInvalidTenantData},
+		{"Dotted ID", "acme.eu", "Acme Corporation", ErrInvalidTenantData},
+		{"Blank Name", "acme", " ", ErrInvalidTenantData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTenant(tt.id, tt.tenantName, time.Now())
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewTenant() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTenantContext(t *testing.T) {
+	if _, err := TenantFromContext(context.Background()); !errors.Is(err, ErrNoTenant) {
+		t.Errorf("TenantFromContext() without tenant error = %v, want ErrNoTenant", err)
+	}
+
+	tenantID, err := TenantFromContext(WithTenant(context.Background(), "acme"))
+	if err != nil || tenantID != "acme" {
+		t.Errorf("TenantFromContext() = %q, %v, want acme", tenantID, err)
+	}
+}
+
+func TestUserAssignTenant(t *testing.T) {
+	user, err := NewUser("Jane Smith", "jane@example.com", 28)
+	if err != nil {
+		t.Fatalf("NewUser() unexpected error = %v", err)
+	}
+
+	if err := user.AssignTenant("acme"); err != nil {
+		t.Fatalf("AssignTenant() unexpected error = %v", err)
+	}
+	if err := user.AssignTenant(DefaultTenantID); err == nil {
+		t.Error("AssignTenant() moved the user to another tenant")
+	}
+	if user.TenantID() != "acme" {
+		t.Errorf("TenantID() = %q, want acme", user.TenantID())
+	}
+}
@@ -2,22 +2,63 @@ package model
 
 import (
 	"errors"
-	"regexp"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"mcanvr/example-golang-api-with-fiber/internal/domain/event"
+
+	"golang.org/x/text/language"
+)
+
+// Profile limits
+const (
+	MaxDisplayNameLength = 100 // Maximum display name length in characters
+	MaxMetadataEntries   = 50  // Maximum number of metadata entries
+	MaxMetadataKeyLength = 64  // Maximum metadata key length in characters
+	MaxMetadataValueSize = 512 // Maximum metadata value length in characters
+)
+
+// Profile defaults
+const (
+	DefaultLocale   = "en"
+	DefaultTimezone = "UTC"
 )
 
 // User represents a user entity in the domain model.
 // It encapsulates user identity and enforces business rules for user data.
 type User struct {
-	id    int    // Private field, accessible via getter
-	name  string // Private field, accessible via getter/setter
-	email string // Private field, accessible via getter/setter
-	age   int    // Private field, accessible via getter/setter
+	id          int               // Private field, accessible via getter
+	tenantID    string            // Private field, set when the user is first persisted
+	name        string            // Private field, accessible via getter/setter
+	displayName string            // Private field, accessible via getter/setter
+	email       Email             // Private field, accessible via getter/setter
+	verifiedAt  time.Time         // Private field, when the current email was verified; zero if unverified
+	password    string            // Private field, hash of the user's password; empty if none is set
+	passwordAt  time.Time         // Private field, when the password was last set
+	phoneNumber PhoneNumber       // Private field, accessible via getter/setter
+	age         Age               // Private field, accessible via getter/setter
+	locale      string            // Private field, accessible via getter/setter
+	timezone    string            // Private field, accessible via getter/setter
+	metadata    map[string]string // Private field, accessible via getter/setter
+	createdAt   time.Time         // Private field, set on creation
+	updatedAt   time.Time         // Private field, refreshed by every setter
+	status      UserStatus        // Private field, changed through Transition
+	events      []event.Event     // Domain events recorded since the user was last saved
 }
 
 // NewUser is a factory function that creates a valid User entity.
 // It enforces business rules during creation, returning errors if validation fails.
+// Optional profile fields start with their defaults and can be set afterwards.
 func NewUser(name, email string, age int) (*User, error) {
-	u := &User{}
+	now := time.Now().UTC()
+	u := &User{
+		locale:    DefaultLocale,
+		timezone:  DefaultTimezone,
+		status:    UserStatusPending,
+		createdAt: now,
+		updatedAt: now,
+	}
 
 	if err := u.SetName(name); err != nil {
 		return nil, err
@@ -31,6 +72,7 @@ func NewUser(name, email string, age int) (*User, error) {
 		return nil, err
 	}
 
+	u.updatedAt = u.createdAt
 	return u, nil
 }
 
@@ -51,6 +93,32 @@ func (u *User) ID() int {
 	return u.id
 }
 
+// AssignID sets the identifier of a new user when it is first persisted.
+// It fails if the user already has an identifier.
+func (u *User) AssignID(id int) error {
+	if u.id != 0 {
+		return fmt.Errorf("user already has id %d", u.id)
+	}
+	u.id = id
+	u.events = append(u.events, UserRegistered{UserID: id, Email: u.email, occurredAt: time.Now().UTC()})
+	return nil
+}
+
+// TenantID returns the ID of the tenant the user belongs to.
+func (u *User) TenantID() string {
+	return u.tenantID
+}
+
+// AssignTenant sets the tenant of a new user when it is first persisted.
+// It fails if the user already belongs to a tenant.
+func (u *User) AssignTenant(tenantID string) error {
+	if u.tenantID != "" {
+		return fmt.Errorf("user already belongs to tenant %q", u.tenantID)
+	}
+	u.tenantID = tenantID
+	return nil
+}
+
 // Name returns the user's name.
 func (u *User) Name() string {
 	return u.name
@@ -62,35 +130,263 @@ func (u *User) SetName(name string) error {
 		return errors.New("name must be at least 2 characters long")
 	}
 	u.name = name
+	u.touch()
+	return nil
+}
+
+// DisplayName returns the name shown to other users, defaulting to the user's name.
+func (u *User) DisplayName() string {
+	if u.displayName == "" {
+		return u.name
+	}
+	return u.displayName
+}
+
+// SetDisplayName updates the user's display name. An empty name resets it to the default.
+func (u *User) SetDisplayName(displayName string) error {
+	if utf8.RuneCountInString(displayName) > MaxDisplayNameLength {
+		return fmt.Errorf("display name must be at most %d characters long", MaxDisplayNameLength)
+	}
+	u.displayName = displayName
+	u.touch()
 	return nil
 }
 
 // Email returns the user's email address.
-func (u *User) Email() string {
+func (u *User) Email() Email {
 	return u.email
 }
 
 // SetEmail updates the user's email, enforcing validation rules.
+// A new address must be verified again, so changing it records a UserEmailChanged event.
 func (u *User) SetEmail(email string) error {
-	// Simple email validation using regex
-	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
-	if !emailRegex.MatchString(email) {
-		return errors.New("invalid email format")
+	address, err := NewEmail(email)
+	if err != nil {
+		return err
 	}
-	u.email = email
+	if address.Equals(u.email) {
+		return nil
+	}
+
+	previous := u.email
+	u.email = address
+	u.verifiedAt = time.Time{}
+	u.touch()
+
+	// Users without an ID are not registered yet; registering records its own event
+	if u.id != 0 {
+		u.events = append(u.events, UserEmailChanged{UserID: u.id, From: previous, To: address, occurredAt: u.updatedAt})
+	}
+	return nil
+}
+
+// EmailVerified reports whether the user's current email address has been verified.
+func (u *User) EmailVerified() bool {
+	return !u.verifiedAt.IsZero()
+}
+
+// EmailVerifiedAt returns when the current email address was verified, or the zero time.
+func (u *User) EmailVerifiedAt() time.Time {
+	return u.verifiedAt
+}
+
+// VerifyEmail marks the email address as verified. It fails with ErrEmailMismatch
+// if the verified address is no longer the user's, e.g. it changed since the link was sent.
+func (u *User) VerifyEmail(email Email) error {
+	if !u.email.Equals(email) {
+		return ErrEmailMismatch
+	}
+	if u.EmailVerified() {
+		return nil
+	}
+	u.touch()
+	u.verifiedAt = u.updatedAt
+	return nil
+}
+
+// PasswordHash returns the hash of the user's password, or an empty string if none is set.
+// The domain never handles plain-text passwords; hashing is left to the application layer.
+func (u *User) PasswordHash() string {
+	return u.password
+}
+
+// HasPassword reports whether the user has set a password.
+func (u *User) HasPassword() bool {
+	return u.password != ""
+}
+
+// PasswordChangedAt returns when the password was last set, or the zero time.
+// Tokens issued before this time are no longer valid.
+func (u *User) PasswordChangedAt() time.Time {
+	return u.passwordAt
+}
+
+// SetPasswordHash replaces the user's password with an already hashed one.
+func (u *User) SetPasswordHash(hash string) error {
+	if hash == "" {
+		return errors.New("password hash must not be empty")
+	}
+	u.password = hash
+	u.touch()
+	u.passwordAt = u.updatedAt
+	return nil
+}
+
+// PhoneNumber returns the user's phone number, which is zero if unset.
+func (u *User) PhoneNumber() PhoneNumber {
+	return u.phoneNumber
+}
+
+// SetPhoneNumber updates the user's phone number. An empty number removes it.
+func (u *User) SetPhoneNumber(number string) error {
+	if number == "" {
+		u.phoneNumber = PhoneNumber{}
+		u.touch()
+		return nil
+	}
+
+	phone, err := NewPhoneNumber(number)
+	if err != nil {
+		return err
+	}
+	u.phoneNumber = phone
+	u.touch()
 	return nil
 }
 
 // Age returns the user's age.
-func (u *User) Age() int {
+func (u *User) Age() Age {
 	return u.age
 }
 
 // SetAge updates the user's age, enforcing business rules.
 func (u *User) SetAge(age int) error {
-	if age < 0 || age > 120 {
-		return errors.New("age must be between 0 and 120")
+	value, err := NewAge(age)
+	if err != nil {
+		return err
+	}
+	u.age = value
+	u.touch()
+	return nil
+}
+
+// Locale returns the user's preferred locale as a BCP 47 language tag.
+func (u *User) Locale() string {
+	return u.locale
+}
+
+// SetLocale updates the user's preferred locale. The tag is stored in canonical
+// form (e.g. "pt-br" becomes "pt-BR"); an empty tag resets it to the default.
+func (u *User) SetLocale(locale string) error {
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return fmt.Errorf("locale must be a valid BCP 47 language tag: %s", locale)
+	}
+	u.locale = tag.String()
+	u.touch()
+	return nil
+}
+
+// Timezone returns the user's IANA time zone name.
+func (u *User) Timezone() string {
+	return u.timezone
+}
+
+// SetTimezone updates the user's IANA time zone (e.g. "Europe/Istanbul").
+// An empty time zone resets it to the default.
+func (u *User) SetTimezone(timezone string) error {
+	if timezone == "" {
+		timezone = DefaultTimezone
+	}
+	if timezone == "Local" {
+		return errors.New("timezone must be an IANA time zone name")
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("timezone must be an IANA time zone name: %s", timezone)
+	}
+	u.timezone = timezone
+	u.touch()
+	return nil
+}
+
+// Metadata returns a copy of the user's free-form metadata.
+func (u *User) Metadata() map[string]string {
+	metadata := make(map[string]string, len(u.metadata))
+	for key, value := range u.metadata {
+		metadata[key] = value
 	}
-	u.age = age
+	return metadata
+}
+
+// SetMetadata replaces the user's free-form metadata, enforcing size limits.
+func (u *User) SetMetadata(metadata map[string]string) error {
+	if len(metadata) > MaxMetadataEntries {
+		return fmt.Errorf("metadata must have at most %d entries", MaxMetadataEntries)
+	}
+
+	copied := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		if key == "" || utf8.RuneCountInString(key) > MaxMetadataKeyLength {
+			return fmt.Errorf("metadata keys must be between 1 and %d characters long", MaxMetadataKeyLength)
+		}
+		if utf8.RuneCountInString(value) > MaxMetadataValueSize {
+			return fmt.Errorf("metadata value for %q must be at most %d characters long", key, MaxMetadataValueSize)
+		}
+		copied[key] = value
+	}
+
+	u.metadata = copied
+	u.touch()
 	return nil
 }
+
+// CreatedAt returns when the user was created.
+func (u *User) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+// UpdatedAt returns when the user was last modified.
+func (u *User) UpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+// RestoreTimestamps sets the creation and modification times,
+// typically used when reconstituting a user from persistent storage.
+func (u *User) RestoreTimestamps(createdAt, updatedAt time.Time) {
+	u.createdAt = createdAt
+	u.updatedAt = updatedAt
+}
+
+// RestoreEmailVerifiedAt sets when the email address was verified,
+// typically used when reconstituting a user from persistent storage.
+func (u *User) RestoreEmailVerifiedAt(verifiedAt time.Time) {
+	u.verifiedAt = verifiedAt
+}
+
+// RestorePassword sets the password hash and when it was set,
+// typically used when reconstituting a user from persistent storage.
+func (u *User) RestorePassword(hash string, changedAt time.Time) {
+	u.password = hash
+	u.passwordAt = changedAt
+}
+
+// RestoreStatus sets the lifecycle state without recording an event,
+// typically used when reconstituting a user from persistent storage.
+func (u *User) RestoreStatus(status UserStatus) {
+	u.status = status
+}
+
+// PullEvents returns the domain events recorded since the last call and clears them.
+func (u *User) PullEvents() []event.Event {
+	events := u.events
+	u.events = nil
+	return events
+}
+
+// touch records a modification of the user
+func (u *User) touch() {
+	u.updatedAt = time.Now().UTC()
+}
@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// Names of the user domain events
+const (
+	EventUserRegistered   = "user.registered"
+	EventUserEmailChanged = "user.email_changed"
+)
+
+// UserRegistered is recorded when a new user is first persisted and receives its ID.
+type UserRegistered struct {
+	UserID     int
+	Email      Email
+	occurredAt time.Time
+}
+
+// Name implements event.Event.
+func (e UserRegistered) Name() string {
+	return EventUserRegistered
+}
+
+// OccurredAt implements event.Event.
+func (e UserRegistered) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// UserEmailChanged is recorded when a registered user's email address changes.
+// The new address is unverified until the user confirms it.
+type UserEmailChanged struct {
+	UserID     int
+	From       Email
+	To         Email
+	occurredAt time.Time
+}
+
+// Name implements event.Event.
+func (e UserEmailChanged) Name() string {
+	return EventUserEmailChanged
+}
+
+// OccurredAt implements event.Event.
+func (e UserEmailChanged) OccurredAt() time.Time {
+	return e.occurredAt
+}
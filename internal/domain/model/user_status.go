@@ -0,0 +1,106 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// UserStatus is the lifecycle state of a user account.
+type UserStatus string
+
+// User lifecycle states
+const (
+	UserStatusPending     UserStatus = "pending"     // Created, not yet activated
+	UserStatusActive      UserStatus = "active"      // In normal use
+	UserStatusSuspended   UserStatus = "suspended"   // Temporarily blocked, e.g. pending an investigation
+	UserStatusDeactivated UserStatus = "deactivated" // Closed; only an admin can reactivate it
+)
+
+// UserTransition is an action moving a user from one lifecycle state to another.
+type UserTransition string
+
+// User lifecycle transitions
+const (
+	UserTransitionActivate   UserTransition = "activate"
+	UserTransitionSuspend    UserTransition = "suspend"
+	UserTransitionDeactivate UserTransition = "deactivate"
+	UserTransitionReactivate UserTransition = "reactivate" // Admin only
+)
+
+// userTransitions lists the states each transition may start from and the state it leads to
+var userTransitions = map[UserTransition]struct {
+	from []UserStatus
+	to   UserStatus
+}{
+	UserTransitionActivate:   {from: []UserStatus{UserStatusPending, UserStatusSuspended}, to: UserStatusActive},
+	UserTransitionSuspend:    {from: []UserStatus{UserStatusActive}, to: UserStatusSuspended},
+	UserTransitionDeactivate: {from: []UserStatus{UserStatusPending, UserStatusActive, UserStatusSuspended}, to: UserStatusDeactivated},
+	UserTransitionReactivate: {from: []UserStatus{UserStatusDeactivated}, to: UserStatusActive},
+}
+
+// ErrInvalidStatusTransition is returned when a transition is not allowed from the user's current state
+var ErrInvalidStatusTransition = errors.New("invalid status transition")
+
+// CanLogIn reports whether users in this state may authenticate.
+func (s UserStatus) CanLogIn() bool {
+	return s == UserStatusPending || s == UserStatusActive
+}
+
+// EventUserStatusChanged is the name of UserStatusChanged events
+const EventUserStatusChanged = "user.status_changed"
+
+// UserStatusChanged is recorded each time a user moves to another lifecycle state.
+type UserStatusChanged struct {
+	UserID     int
+	From       UserStatus
+	To         UserStatus
+	Transition UserTransition
+	Actor      string // Who performed the transition
+	Reason     string // Optional explanation, e.g. why a user was suspended
+	occurredAt time.Time
+}
+
+// Name implements event.Event.
+func (e UserStatusChanged) Name() string {
+	return EventUserStatusChanged
+}
+
+// OccurredAt implements event.Event.
+func (e UserStatusChanged) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// Status returns the user's lifecycle state.
+func (u *User) Status() UserStatus {
+	return u.status
+}
+
+// Transition moves the user to another lifecycle state and records a
+// UserStatusChanged event. It returns an error wrapping
+// ErrInvalidStatusTransition if the transition is not allowed from the current state.
+func (u *User) Transition(transition UserTransition, actor, reason string) error {
+	rule, ok := userTransitions[transition]
+	if !ok {
+		return fmt.Errorf("%w: unknown transition %q", ErrInvalidStatusTransition, transition)
+	}
+	if !slices.Contains(rule.from, u.status) {
+		return fmt.Errorf("%w: cannot %s a %s user", ErrInvalidStatusTransition, transition, u.status)
+	}
+
+	from := u.status
+	u.status = rule.to
+	u.touch()
+
+	u.events = append(u.events, UserStatusChanged{
+		UserID:     u.id,
+		From:       from,
+		To:         rule.to,
+		Transition: transition,
+		Actor:      actor,
+		Reason:     reason,
+		occurredAt: u.updatedAt,
+	})
+	return nil
+}
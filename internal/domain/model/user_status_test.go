@@ -0,0 +1,72 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUserTransition(t *testing.T) {
+	tests := []struct {
+		name       string
+		from       UserStatus
+		transition UserTransition
+		want       UserStatus
+		wantErr    bool
+	}{
+		{"Activate Pending", UserStatusPending, UserTransitionActivate, UserStatusActive, false},
+		{"Suspend Active", UserStatusActive, UserTransitionSuspend, UserStatusSuspended, false},
+		{"Activate Suspended", UserStatusSuspended, UserTransitionActivate, UserStatusActive, false},
+		{"Deactivate Suspended", UserStatusSuspended, UserTransitionDeactivate, UserStatusDeactivated, false},
+		{"Reactivate Deactivated", UserStatusDeactivated, UserTransitionReactivate, UserStatusActive, false},
+		{"Suspend Pending", UserStatusPending, UserTransitionSuspend, UserStatusPending, true},
+		{"Activate Deactivated", UserStatusDeactivated, UserTransitionActivate, UserStatusDeactivated, true},
+		{"Reactivate Active", UserStatusActive, UserTransitionReactivate, UserStatusActive, true},
+		{"Unknown Transition", UserStatusActive, UserTransition("archive"), UserStatusActive, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, _ := NewUserWithID(1, "John Doe", "john@example.com", 30)
+			user.RestoreStatus(tt.from)
+
+			err := user.Transition(tt.transition, "admin", "testing")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Transition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidStatusTransition) {
+				t.Errorf("Transition() error = %v, want ErrInvalidStatusTransition", err)
+			}
+			if user.Status() != tt.want {
+				t.Errorf("Status() = %v, want %v", user.Status(), tt.want)
+			}
+
+			events := user.PullEvents()
+			if tt.wantErr {
+				if len(events) != 0 {
+					t.Errorf("PullEvents() = %v, want none", events)
+				}
+				return
+			}
+			if len(events) != 1 {
+				t.Fatalf("PullEvents() returned %d events, want 1", len(events))
+			}
+			changed, ok := events[0].(UserStatusChanged)
+			if !ok || changed.From != tt.from || changed.To != tt.want || changed.Actor != "admin" {
+				t.Errorf("PullEvents() = %+v, want change from %v to %v by admin", events[0], tt.from, tt.want)
+			}
+			if len(user.PullEvents()) != 0 {
+				t.Error("PullEvents() did not clear the recorded events")
+			}
+		})
+	}
+}
+
+func TestNewUserIsPending(t *testing.T) {
+	user, _ := NewUser("John Doe", "john@example.com", 30)
+	if user.Status() != UserStatusPending {
+		t.Errorf("Status() = %v, want %v", user.Status(), UserStatusPending)
+	}
+	if !user.Status().CanLogIn() {
+		t.Error("CanLogIn() = false for a pending user, want true")
+	}
+}
@@ -64,10 +64,10 @@ func TestNewUser(t *testing.T) {
 				if user.Name() != tt.userName {
 					t.Errorf("user.Name() = %v, want %v", user.Name(), tt.userName)
 				}
-				if user.Email() != tt.email {
+				if user.Email().String() != tt.email {
 					t.Errorf("user.Email() = %v, want %v", user.Email(), tt.email)
 				}
-				if user.Age() != tt.age {
+				if user.Age().Int() != tt.age {
 					t.Errorf("user.Age() = %v, want %v", user.Age(), tt.age)
 				}
 			}
@@ -94,11 +94,11 @@ func TestNewUserWithID(t *testing.T) {
 		t.Errorf("user.Name() = %v, want %v", user.Name(), name)
 	}
 
-	if user.Email() != email {
+	if user.Email().String() != email {
 		t.Errorf("user.Email() = %v, want %v", user.Email(), email)
 	}
 
-	if user.Age() != age {
+	if user.Age().Int() != age {
 		t.Errorf("user.Age() = %v, want %v", user.Age(), age)
 	}
 }
@@ -130,7 +130,7 @@ func TestUserSetters(t *testing.T) {
 		if err := user.SetEmail("new@example.com"); err != nil {
 			t.Errorf("SetEmail() unexpected error = %v", err)
 		}
-		if user.Email() != "new@example.com" {
+		if user.Email().String() != "new@example.com" {
 			t.Errorf("user.Email() = %v, want %v", user.Email(), "new@example.com")
 		}
 	})
@@ -145,7 +145,7 @@ func TestUserSetters(t *testing.T) {
 		if err := user.SetAge(30); err != nil {
 			t.Errorf("SetAge() unexpected error = %v", err)
 		}
-		if user.Age() != 30 {
+		if user.Age().Int() != 30 {
 			t.Errorf("user.Age() = %v, want %v", user.Age(), 30)
 		}
 	})
@@ -156,3 +156,70 @@ func TestUserSetters(t *testing.T) {
 		}
 	})
 }
+
+func TestUserProfile(t *testing.T) {
+	user, err := NewUser("John Doe", "John@Example.com", 30)
+	if err != nil {
+		t.Fatalf("NewUser() unexpected error = %v", err)
+	}
+
+	t.Run("Defaults", func(t *testing.T) {
+		if user.Email().String() != "john@example.com" {
+			t.Errorf("user.Email() = %v, want normalized lowercase address", user.Email())
+		}
+		if user.DisplayName() != "John Doe" {
+			t.Errorf("user.DisplayName() = %v, want the name", user.DisplayName())
+		}
+		if user.Locale() != DefaultLocale || user.Timezone() != DefaultTimezone {
+			t.Errorf("locale, timezone = %v, %v, want defaults", user.Locale(), user.Timezone())
+		}
+		if !user.PhoneNumber().IsZero() {
+			t.Errorf("user.PhoneNumber() = %v, want unset", user.PhoneNumber())
+		}
+		if user.CreatedAt().IsZero() || !user.UpdatedAt().Equal(user.CreatedAt()) {
+			t.Errorf("timestamps = %v, %v, want equal creation times", user.CreatedAt(), user.UpdatedAt())
+		}
+	})
+
+	t.Run("Setters", func(t *testing.T) {
+		if err := user.SetPhoneNumber("+1 (415) 555-2671"); err != nil || user.PhoneNumber().String() != "+14155552671" {
+			t.Errorf("SetPhoneNumber() = %v, %v, want +14155552671", user.PhoneNumber(), err)
+		}
+		if err := user.SetLocale("pt-br"); err != nil || user.Locale() != "pt-BR" {
+			t.Errorf("SetLocale() = %v, %v, want pt-BR", user.Locale(), err)
+		}
+		if err := user.SetTimezone("Europe/Istanbul"); err != nil {
+			t.Errorf("SetTimezone() unexpected error = %v", err)
+		}
+		if user.UpdatedAt().Before(user.CreatedAt()) {
+			t.Errorf("user.UpdatedAt() = %v, want not before creation", user.UpdatedAt())
+		}
+	})
+
+	t.Run("Invalid Values", func(t *testing.T) {
+		if err := user.SetPhoneNumber("555-2671"); err == nil {
+			t.Errorf("SetPhoneNumber() without country code error = nil, want error")
+		}
+		if err := user.SetLocale("not a locale"); err == nil {
+			t.Errorf("SetLocale() error = nil, want error")
+		}
+		if err := user.SetTimezone("Mars/Olympus_Mons"); err == nil {
+			t.Errorf("SetTimezone() error = nil, want error")
+		}
+		if err := user.SetMetadata(map[string]string{"": "value"}); err == nil {
+			t.Errorf("SetMetadata() with empty key error = nil, want error")
+		}
+	})
+
+	t.Run("Metadata Is Copied", func(t *testing.T) {
+		metadata := map[string]string{"team": "core"}
+		if err := user.SetMetadata(metadata); err != nil {
+			t.Fatalf("SetMetadata() unexpected error = %v", err)
+		}
+		metadata["team"] = "changed"
+		user.Metadata()["team"] = "changed"
+		if got := user.Metadata()["team"]; got != "core" {
+			t.Errorf("user.Metadata()[team] = %v, want core", got)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"time"
+)
+
+// APIKeyRepository defines the contract for storing API keys.
+type APIKeyRepository interface {
+	// FindAll retrieves all keys, including revoked and expired ones.
+	FindAll(ctx context.Context) ([]*model.APIKey, error)
+
+	// FindByID retrieves a key by its identifier.
+	FindByID(ctx context.Context, id int) (*model.APIKey, error)
+
+	// FindByPrefix retrieves a key by its public prefix.
+	FindByPrefix(ctx context.Context, prefix string) (*model.APIKey, error)
+
+	// Save persists a key (create or update), assigning an ID to new keys.
+	Save(ctx context.Context, key *model.APIKey) error
+
+	// MarkUsed records that a key was used at the given time, leaving the rest
+	// of the stored key untouched so a concurrent revocation is not undone.
+	MarkUsed(ctx context.Context, id int, at time.Time) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// AuditRepository defines the contract for storing audit events.
+// Audit events are append-only: they are never updated or deleted.
+type AuditRepository interface {
+	// Append stores a new audit event.
+	Append(ctx context.Context, event *model.AuditEvent) error
+
+	// FindAll retrieves all audit events in the order they were recorded.
+	FindAll(ctx context.Context) ([]*model.AuditEvent, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// EmailVerificationRepository defines the contract for storing outstanding email verifications.
+// A user has at most one verification at a time.
+type EmailVerificationRepository interface {
+	// FindByUserID retrieves the outstanding verification of a user.
+	FindByUserID(ctx context.Context, userID int) (*model.EmailVerification, error)
+
+	// Save persists a verification, replacing any previous one of the same user.
+	Save(ctx context.Context, verification *model.EmailVerification) error
+
+	// Delete removes the outstanding verification of a user.
+	Delete(ctx context.Context, userID int) error
+}
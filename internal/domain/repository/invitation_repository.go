@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// InvitationRepository defines the contract for storing invitations to teams.
+// Invitations are reached through their organization, which is tenant-scoped.
+type InvitationRepository interface {
+	// FindByID retrieves an invitation by its identifier.
+	FindByID(ctx context.Context, id int) (*model.Invitation, error)
+
+	// FindByTokenHash retrieves the invitation whose token has the given hash.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*model.Invitation, error)
+
+	// FindByTeamID retrieves every invitation to a team, including answered ones.
+	FindByTeamID(ctx context.Context, teamID int) ([]*model.Invitation, error)
+
+	// Save persists an invitation (create or update), assigning an ID to new invitations.
+	Save(ctx context.Context, invitation *model.Invitation) error
+
+	// Delete removes an invitation.
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"time"
+)
+
+// LoginAttemptRepository defines the contract for tracking failed login attempts.
+type LoginAttemptRepository interface {
+	// FindByKey retrieves the attempts recorded for a subject key.
+	// It returns an empty record when no attempts are known.
+	FindByKey(ctx context.Context, key string) (*model.LoginAttempts, error)
+
+	// RecordFailure registers a failed attempt for a subject key and applies
+	// the policy as a single operation, so concurrent failures are all counted.
+	// It returns the updated attempts and whether this failure triggered a new lockout.
+	RecordFailure(ctx context.Context, key string, now time.Time, policy model.LockoutPolicy) (*model.LoginAttempts, bool, error)
+
+	// DeleteExpired removes the attempts that no longer affect future attempts under the policy.
+	DeleteExpired(ctx context.Context, now time.Time, policy model.LockoutPolicy) error
+
+	// Delete removes the attempts recorded for a subject key.
+	Delete(ctx context.Context, key string) error
+}
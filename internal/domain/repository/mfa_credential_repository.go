@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// MFACredentialRepository defines the contract for storing users' MFA credentials.
+// A user has at most one credential at a time.
+type MFACredentialRepository interface {
+	// FindByUserID retrieves the credential of a user.
+	FindByUserID(ctx context.Context, userID int) (*model.MFACredential, error)
+
+	// Save persists a credential, replacing any previous one of the same user.
+	Save(ctx context.Context, credential *model.MFACredential) error
+
+	// Delete removes the credential of a user.
+	Delete(ctx context.Context, userID int) error
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// OAuthClientRepository defines the contract for storing registered OAuth clients.
+type OAuthClientRepository interface {
+	// FindAll retrieves all clients.
+	FindAll(ctx context.Context) ([]*model.OAuthClient, error)
+
+	// FindByID retrieves a client by its identifier.
+	FindByID(ctx context.Context, id int) (*model.OAuthClient, error)
+
+	// FindByClientID retrieves a client by the public identifier it authenticates with.
+	FindByClientID(ctx context.Context, clientID string) (*model.OAuthClient, error)
+
+	// Save persists a client (create or update), assigning an ID to new clients.
+	Save(ctx context.Context, client *model.OAuthClient) error
+
+	// Delete removes a client.
+	Delete(ctx context.Context, id int) error
+}
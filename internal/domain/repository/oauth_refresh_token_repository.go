@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// OAuthRefreshTokenRepository defines the contract for storing OAuth refresh tokens.
+type OAuthRefreshTokenRepository interface {
+	// FindByTokenHash retrieves the refresh token with the given hash.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*model.OAuthRefreshToken, error)
+
+	// Save persists a refresh token (create or update).
+	Save(ctx context.Context, token *model.OAuthRefreshToken) error
+
+	// DeleteByClientID removes every refresh token issued to a client.
+	DeleteByClientID(ctx context.Context, clientID string) error
+}
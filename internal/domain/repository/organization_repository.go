@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// OrganizationRepository defines the contract for storing organizations.
+//
+// Like UserRepository, every method is scoped to the tenant of its context:
+// organizations of other tenants are never found or saved.
+type OrganizationRepository interface {
+	// FindByID retrieves an organization by its identifier.
+	FindByID(ctx context.Context, id int) (*model.Organization, error)
+
+	// FindByMember retrieves every organization a user is a member of.
+	FindByMember(ctx context.Context, userID int) ([]*model.Organization, error)
+
+	// Save persists an organization (create or update), assigning an ID to new organizations.
+	Save(ctx context.Context, organization *model.Organization) error
+
+	// Delete removes an organization.
+	Delete(ctx context.Context, id int) error
+}
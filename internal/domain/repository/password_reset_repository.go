@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// PasswordResetRepository defines the contract for storing outstanding password resets.
+// A user has at most one reset at a time.
+type PasswordResetRepository interface {
+	// FindByTokenHash retrieves the reset whose token has the given hash.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*model.PasswordReset, error)
+
+	// FindByUserID retrieves the outstanding reset of a user.
+	FindByUserID(ctx context.Context, userID int) (*model.PasswordReset, error)
+
+	// Save persists a reset, replacing any previous one of the same user.
+	Save(ctx context.Context, reset *model.PasswordReset) error
+
+	// Delete removes the outstanding reset of a user.
+	Delete(ctx context.Context, userID int) error
+}
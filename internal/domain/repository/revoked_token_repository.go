@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// RevokedTokenRepository defines the contract for storing revoked access tokens.
+// Access tokens are self-contained JWTs, so revoking one means remembering its
+// "jti" claim until the token would have expired anyway.
+type RevokedTokenRepository interface {
+	// Add records a revoked token until it expires.
+	Add(ctx context.Context, tokenID string, expiresAt time.Time) error
+
+	// Contains reports whether a token was revoked.
+	Contains(ctx context.Context, tokenID string) (bool, error)
+}
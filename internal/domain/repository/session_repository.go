@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// SessionRepository defines the contract for storing user sessions.
+type SessionRepository interface {
+	// FindByID retrieves a session by its identifier.
+	FindByID(ctx context.Context, id int) (*model.Session, error)
+
+	// FindByRefreshTokenHash retrieves the session whose current refresh token has the given hash.
+	FindByRefreshTokenHash(ctx context.Context, tokenHash string) (*model.Session, error)
+
+	// FindByUserID retrieves every session of a user, including revoked ones.
+	FindByUserID(ctx context.Context, userID int) ([]*model.Session, error)
+
+	// Save persists a session (create or update), assigning an ID to new sessions.
+	Save(ctx context.Context, session *model.Session) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// TeamRepository defines the contract for storing the teams of organizations.
+// Teams are reached through their organization, which is tenant-scoped.
+type TeamRepository interface {
+	// FindByID retrieves a team by its identifier.
+	FindByID(ctx context.Context, id int) (*model.Team, error)
+
+	// FindByOrganizationID retrieves every team of an organization.
+	FindByOrganizationID(ctx context.Context, organizationID int) ([]*model.Team, error)
+
+	// Save persists a team (create or update), assigning an ID to new teams.
+	Save(ctx context.Context, team *model.Team) error
+
+	// Delete removes a team.
+	Delete(ctx context.Context, id int) error
+}
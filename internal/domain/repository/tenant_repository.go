@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+)
+
+// TenantRepository defines the contract for storing tenants.
+type TenantRepository interface {
+	// FindByID retrieves a tenant by its identifier.
+	FindByID(ctx context.Context, id string) (*model.Tenant, error)
+
+	// FindAll retrieves all tenants.
+	FindAll(ctx context.Context) ([]*model.Tenant, error)
+
+	// Save persists a tenant (create or update).
+	Save(ctx context.Context, tenant *model.Tenant) error
+}
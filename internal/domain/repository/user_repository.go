@@ -7,6 +7,10 @@ import (
 
 // UserRepository defines the contract for user persistence operations.
 // This follows the Repository Pattern from DDD, which abstracts the data access layer.
+//
+// Every method is scoped to the tenant of its context, see model.WithTenant:
+// users of other tenants are never found, and email addresses are unique within
+// a tenant only. Methods fail with model.ErrNoTenant if the context has no tenant.
 type UserRepository interface {
 	// FindByID retrieves a user by their unique identifier.
 	FindByID(ctx context.Context, id int) (*model.User, error)
@@ -14,12 +18,17 @@ type UserRepository interface {
 	// FindAll retrieves all users.
 	FindAll(ctx context.Context) ([]*model.User, error)
 
-	// Save persists a user entity (create or update).
+	// Save persists a user entity (create or update). New users join the tenant of the context.
+	// It fails with model.ErrEmailInUse if another user of the tenant has the email address,
+	// checked atomically with the write, and with appErrors.ErrNotFound for users of other tenants.
 	Save(ctx context.Context, user *model.User) error
 
 	// Delete removes a user from the repository.
 	Delete(ctx context.Context, id int) error
 
+	// FindByEmail retrieves a user by their email address.
+	FindByEmail(ctx context.Context, email string) (*model.User, error)
+
 	// ExistsByEmail checks if a user with the given email exists.
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 }
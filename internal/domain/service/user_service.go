@@ -4,32 +4,138 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/event"
 	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
 	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
 	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/http"
 )
 
 // Predefined domain errors
 var (
 	ErrUserNotFound      = errors.New("user not found")
-	ErrUserAlreadyExists = errors.New("user with this email already exists")
+	ErrUserAlreadyExists = model.ErrEmailInUse // Also returned by repositories saving a taken address
 	ErrInvalidUserData   = errors.New("invalid user data")
 	ErrRepositoryError   = errors.New("repository operation failed")
 )
 
+// Register how the domain errors are reported to API clients
+func init() {
+	appErrors.Register(ErrUserNotFound, appErrors.Mapping{
+		Status: http.StatusNotFound,
+		Code:   appErrors.CodeUserNotFound,
+		Title:  constants.UserNotFound,
+	})
+	appErrors.Register(ErrUserAlreadyExists, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeEmailInUse,
+		Title:  constants.EmailAlreadyInUse,
+	})
+	appErrors.Register(ErrInvalidUserData, appErrors.Mapping{
+		Status: http.StatusBadRequest,
+		Code:   appErrors.CodeInvalidRequest,
+		Title:  constants.InvalidRequestFormat,
+	})
+	appErrors.Register(ErrRepositoryError, appErrors.Mapping{
+		Status: http.StatusInternalServerError,
+		Code:   appErrors.CodeInternal,
+		Title:  constants.InternalServerError,
+		Detail: constants.TransactionFailedUI,
+	})
+	appErrors.Register(model.ErrInvalidStatusTransition, appErrors.Mapping{
+		Status: http.StatusConflict,
+		Code:   appErrors.CodeInvalidTransition,
+		Title:  constants.InvalidStatusTransition,
+	})
+}
+
+// notFoundOrCanceled converts a repository lookup failure into a not-found error,
+// unless the lookup was aborted because the request context was canceled.
+func notFoundOrCanceled(err error, id int) error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return err
+	}
+	return &appErrors.ErrNotFound{Resource: "user", ID: id}
+}
+
+// UserData carries the attributes of a user to create or update.
+// Optional profile fields left empty take their default values.
+type UserData struct {
+	Name        string
+	DisplayName string
+	Email       string
+	PhoneNumber string
+	Age         int
+	Locale      string
+	Timezone    string
+	Metadata    map[string]string
+}
+
+// applyUserData sets every attribute of a user from data
+func applyUserData(user *model.User, data UserData) error {
+	if err := user.SetName(data.Name); err != nil {
+		return &appErrors.ErrInvalidRequest{Field: "name", Message: err.Error()}
+	}
+	if err := user.SetEmail(data.Email); err != nil {
+		return &appErrors.ErrInvalidRequest{Field: "email", Message: err.Error()}
+	}
+	if err := user.SetAge(data.Age); err != nil {
+		return &appErrors.ErrInvalidRequest{Field: "age", Message: err.Error()}
+	}
+	return applyProfile(user, data)
+}
+
+// applyProfile sets the optional profile fields of a user from data
+func applyProfile(user *model.User, data UserData) error {
+	if err := user.SetDisplayName(data.DisplayName); err != nil {
+		return &appErrors.ErrInvalidRequest{Field: "display_name", Message: err.Error()}
+	}
+	if err := user.SetPhoneNumber(data.PhoneNumber); err != nil {
+		return &appErrors.ErrInvalidRequest{Field: "phone_number", Message: err.Error()}
+	}
+	if err := user.SetLocale(data.Locale); err != nil {
+		return &appErrors.ErrInvalidRequest{Field: "locale", Message: err.Error()}
+	}
+	if err := user.SetTimezone(data.Timezone); err != nil {
+		return &appErrors.ErrInvalidRequest{Field: "timezone", Message: err.Error()}
+	}
+	if err := user.SetMetadata(data.Metadata); err != nil {
+		return &appErrors.ErrInvalidRequest{Field: "metadata", Message: err.Error()}
+	}
+	return nil
+}
+
 // UserService contains core domain logic for user operations.
 // It enforces business rules that span multiple entities or repositories.
 type UserService struct {
-	userRepo repository.UserRepository
+	userRepo  repository.UserRepository
+	publisher event.Publisher
 }
 
 // NewUserService creates a new instance of the user domain service.
-func NewUserService(userRepo repository.UserRepository) *UserService {
+// The domain events recorded by users are delivered through publisher once the user is saved.
+func NewUserService(userRepo repository.UserRepository, publisher event.Publisher) *UserService {
 	return &UserService{
-		userRepo: userRepo,
+		userRepo:  userRepo,
+		publisher: publisher,
 	}
 }
 
+// save persists a user and publishes the domain events it recorded
+func (s *UserService) save(ctx context.Context, user *model.User) error {
+	if err := s.userRepo.Save(ctx, user); err != nil {
+		// A taken address or a user of another tenant is reported as such
+		var notFound *appErrors.ErrNotFound
+		if errors.Is(err, ErrUserAlreadyExists) || errors.As(err, &notFound) {
+			return err
+		}
+		return fmt.Errorf("%w: %w", ErrRepositoryError, err)
+	}
+	s.publisher.Publish(ctx, user.PullEvents()...)
+	return nil
+}
+
 // GetUserByID retrieves a user by ID, enforcing access rules if needed.
 func (s *UserService) GetUserByID(ctx context.Context, id int) (*model.User, error) {
 	if id <= 0 {
@@ -38,7 +144,24 @@ func (s *UserService) GetUserByID(ctx context.Context, id int) (*model.User, err
 
 	user, err := s.userRepo.FindByID(ctx, id)
 	if err != nil {
-		return nil, &appErrors.ErrNotFound{Resource: "user", ID: id}
+		return nil, notFoundOrCanceled(err, id)
+	}
+	return user, nil
+}
+
+// GetUserByEmail retrieves a user by email address.
+func (s *UserService) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	address, err := model.NewEmail(email)
+	if err != nil {
+		return nil, &appErrors.ErrInvalidRequest{Field: "email", Message: err.Error()}
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, address.String())
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		return nil, ErrUserNotFound
 	}
 	return user, nil
 }
@@ -47,75 +170,86 @@ func (s *UserService) GetUserByID(ctx context.Context, id int) (*model.User, err
 func (s *UserService) GetAllUsers(ctx context.Context) ([]*model.User, error) {
 	users, err := s.userRepo.FindAll(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRepositoryError, err)
+		return nil, fmt.Errorf("%w: %w", ErrRepositoryError, err)
 	}
 	return users, nil
 }
 
 // CreateUser handles the creation of a new user, enforcing uniqueness rules.
-func (s *UserService) CreateUser(ctx context.Context, name, email string, age int) (*model.User, error) {
+func (s *UserService) CreateUser(ctx context.Context, data UserData) (*model.User, error) {
+	email, err := model.NewEmail(data.Email)
+	if err != nil {
+		return nil, &appErrors.ErrInvalidRequest{Field: "email", Message: err.Error()}
+	}
+
 	// Check if email is already in use
-	exists, err := s.userRepo.ExistsByEmail(ctx, email)
+	exists, err := s.userRepo.ExistsByEmail(ctx, email.String())
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRepositoryError, err)
+		return nil, fmt.Errorf("%w: %w", ErrRepositoryError, err)
 	}
 	if exists {
 		return nil, fmt.Errorf("%w: %s", ErrUserAlreadyExists, email)
 	}
 
 	// Create new user entity
-	user, err := model.NewUser(name, email, age)
+	user, err := model.NewUser(data.Name, data.Email, data.Age)
 	if err != nil {
 		return nil, &appErrors.ErrInvalidRequest{Field: "user data", Message: err.Error()}
 	}
+	if err := applyProfile(user, data); err != nil {
+		return nil, err
+	}
 
 	// Persist the user
-	if err := s.userRepo.Save(ctx, user); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRepositoryError, err)
+	if err := s.save(ctx, user); err != nil {
+		return nil, err
 	}
 
 	return user, nil
 }
 
 // UpdateUser handles updating an existing user.
-func (s *UserService) UpdateUser(ctx context.Context, id int, name, email string, age int) (*model.User, error) {
+// All attributes are replaced, so optional profile fields left empty are reset.
+func (s *UserService) UpdateUser(ctx context.Context, id int, data UserData) (*model.User, error) {
 	if id <= 0 {
 		return nil, fmt.Errorf("%w: invalid ID value", ErrInvalidUserData)
 	}
 
+	// Validate every attribute before touching the stored user, so a
+	// rejected update changes nothing and records no events
+	if err := applyUserData(new(model.User), data); err != nil {
+		return nil, err
+	}
+	email, err := model.NewEmail(data.Email)
+	if err != nil {
+		return nil, &appErrors.ErrInvalidRequest{Field: "email", Message: err.Error()}
+	}
+
 	// Fetch existing user
 	user, err := s.userRepo.FindByID(ctx, id)
 	if err != nil {
-		return nil, &appErrors.ErrNotFound{Resource: "user", ID: id}
+		return nil, notFoundOrCanceled(err, id)
 	}
 
 	// If email changed, verify it's not in use
-	if user.Email() != email {
-		exists, err := s.userRepo.ExistsByEmail(ctx, email)
+	if !user.Email().Equals(email) {
+		exists, err := s.userRepo.ExistsByEmail(ctx, email.String())
 		if err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrRepositoryError, err)
+			return nil, fmt.Errorf("%w: %w", ErrRepositoryError, err)
 		}
 		if exists {
 			return nil, fmt.Errorf("%w: %s", ErrUserAlreadyExists, email)
 		}
 	}
 
-	// Update the user properties with validation
-	if err := user.SetName(name); err != nil {
-		return nil, &appErrors.ErrInvalidRequest{Field: "name", Message: err.Error()}
-	}
-
-	if err := user.SetEmail(email); err != nil {
-		return nil, &appErrors.ErrInvalidRequest{Field: "email", Message: err.Error()}
-	}
-
-	if err := user.SetAge(age); err != nil {
-		return nil, &appErrors.ErrInvalidRequest{Field: "age", Message: err.Error()}
+	// Update the user properties, already known to be valid
+	if err := applyUserData(user, data); err != nil {
+		return nil, err
 	}
 
 	// Save changes
-	if err := s.userRepo.Save(ctx, user); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRepositoryError, err)
+	if err := s.save(ctx, user); err != nil {
+		return nil, err
 	}
 
 	return user, nil
@@ -130,13 +264,81 @@ func (s *UserService) DeleteUser(ctx context.Context, id int) error {
 	// Check if user exists
 	_, err := s.userRepo.FindByID(ctx, id)
 	if err != nil {
-		return &appErrors.ErrNotFound{Resource: "user", ID: id}
+		return notFoundOrCanceled(err, id)
 	}
 
 	// Delete the user
 	if err := s.userRepo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("%w: %v", ErrRepositoryError, err)
+		return fmt.Errorf("%w: %w", ErrRepositoryError, err)
 	}
 
 	return nil
 }
+
+// TransitionUser moves a user to another lifecycle state, e.g. suspending it.
+// The actor performing the transition and an optional reason are recorded in the
+// published UserStatusChanged event. Transitions not allowed from the user's current
+// state return an error wrapping model.ErrInvalidStatusTransition.
+func (s *UserService) TransitionUser(ctx context.Context, id int, transition model.UserTransition, actor, reason string) (*model.User, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: invalid ID value", ErrInvalidUserData)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, notFoundOrCanceled(err, id)
+	}
+
+	if err := user.Transition(transition, actor, reason); err != nil {
+		return nil, err
+	}
+
+	if err := s.save(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// VerifyEmail marks a user's email address as verified. It fails with an error
+// wrapping model.ErrEmailMismatch if the address is no longer the user's.
+func (s *UserService) VerifyEmail(ctx context.Context, id int, email string) (*model.User, error) {
+	address, err := model.NewEmail(email)
+	if err != nil {
+		return nil, &appErrors.ErrInvalidRequest{Field: "email", Message: err.Error()}
+	}
+
+	user, err := s.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, notFoundOrCanceled(err, id)
+	}
+
+	if err := user.VerifyEmail(address); err != nil {
+		return nil, err
+	}
+
+	if err := s.save(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ChangePassword replaces a user's password with an already hashed one.
+// Tokens issued to the user before the change are no longer valid.
+func (s *UserService) ChangePassword(ctx context.Context, id int, passwordHash string) (*model.User, error) {
+	user, err := s.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, notFoundOrCanceled(err, id)
+	}
+
+	if err := user.SetPasswordHash(passwordHash); err != nil {
+		return nil, &appErrors.ErrInvalidRequest{Field: "password", Message: err.Error()}
+	}
+
+	if err := s.save(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"mcanvr/example-golang-api-with-fiber/internal/domain/event"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+)
+
+// recordingPublisher keeps the events published to it
+type recordingPublisher struct {
+	events []event.Event
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events ...event.Event) {
+	p.events = append(p.events, events...)
+}
+
+func TestUpdateUserRejectedLeavesUserUnchanged(t *testing.T) {
+	ctx := model.WithTenant(context.Background(), model.DefaultTenantID)
+	publisher := &recordingPublisher{}
+	service := NewUserService(inmemory.NewInMemoryUserRepository(), publisher)
+
+	user, err := service.CreateUser(ctx, UserData{Name: "John", Email: "john@example.com", Age: 30})
+	if err != nil {
+		t.Fatalf("CreateUser() unexpected error = %v", err)
+	}
+	publisher.events = nil
+
+	tests := []struct {
+		name string
+		data UserData
+	}{
+		{
+			name: "Invalid Age",
+			data: UserData{Name: "Johnny", Email: "johnny@example.com", Age: -1},
+		},
+		{
+			name: "Invalid Timezone",
+			data: UserData{Name: "Johnny", Email: "johnny@example.com", Age: 31, Timezone: "Mars/Olympus"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.UpdateUser(ctx, user.ID(), tt.data); err == nil {
+				t.Fatalf("UpdateUser() error = nil, want validation error")
+			}
+
+			stored, err := service.GetUserByID(ctx, user.ID())
+			if err != nil {
+				t.Fatalf("GetUserByID() unexpected error = %v", err)
+			}
+			if stored.Name() != "John" || stored.Email().String() != "john@example.com" {
+				t.Errorf("stored user = %s <%s>, want unchanged John <john@example.com>", stored.Name(), stored.Email())
+			}
+			if len(publisher.events) != 0 {
+				t.Errorf("published %d events, want none", len(publisher.events))
+			}
+		})
+	}
+
+	// A later successful update publishes only its own email change
+	if _, err := service.UpdateUser(ctx, user.ID(), UserData{Name: "John", Email: "john@example.org", Age: 30}); err != nil {
+		t.Fatalf("UpdateUser() unexpected error = %v", err)
+	}
+	if len(publisher.events) != 1 {
+		t.Errorf("published %d events, want 1 email change", len(publisher.events))
+	}
+}
+
+func TestUserRepositoryReturnsCopies(t *testing.T) {
+	ctx := model.WithTenant(context.Background(), model.DefaultTenantID)
+	service := NewUserService(inmemory.NewInMemoryUserRepository(), &recordingPublisher{})
+
+	user, err := service.CreateUser(ctx, UserData{Name: "John", Email: "john@example.com", Age: 30})
+	if err != nil {
+		t.Fatalf("CreateUser() unexpected error = %v", err)
+	}
+
+	fetched, err := service.GetUserByID(ctx, user.ID())
+	if err != nil {
+		t.Fatalf("GetUserByID() unexpected error = %v", err)
+	}
+	if err := fetched.SetName("Changed"); err != nil {
+		t.Fatalf("SetName() unexpected error = %v", err)
+	}
+
+	stored, err := service.GetUserByID(ctx, user.ID())
+	if err != nil {
+		t.Fatalf("GetUserByID() unexpected error = %v", err)
+	}
+	if stored.Name() != "John" {
+		t.Errorf("stored name = %q, want %q until the user is saved", stored.Name(), "John")
+	}
+}
+
+// checkBarrier holds every ExistsByEmail call until all expected callers have checked,
+// so that concurrent creations all pass the check before any of them is saved
+type checkBarrier struct {
+	repository.UserRepository
+	checked sync.WaitGroup
+}
+
+func (r *checkBarrier) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	exists, err := r.UserRepository.ExistsByEmail(ctx, email)
+	r.checked.Done()
+	r.checked.Wait()
+	return exists, err
+}
+
+func TestCreateUserConcurrentEmail(t *testing.T) {
+	const requests = 20
+	ctx := model.WithTenant(context.Background(), model.DefaultTenantID)
+	repo := &checkBarrier{UserRepository: inmemory.NewInMemoryUserRepository()}
+	repo.checked.Add(requests)
+	service := NewUserService(repo, &recordingPublisher{})
+
+	errs := make(chan error, requests)
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.CreateUser(ctx, UserData{Name: "John", Email: "john@example.com", Age: 30})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	created := 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			created++
+		case !errors.Is(err, ErrUserAlreadyExists):
+			t.Errorf("CreateUser() error = %v, want %v", err, ErrUserAlreadyExists)
+		}
+	}
+	if created != 1 {
+		t.Errorf("created %d users, want 1", created)
+	}
+
+	users, err := service.GetAllUsers(ctx)
+	if err != nil {
+		t.Fatalf("GetAllUsers() unexpected error = %v", err)
+	}
+	if len(users) != 1 {
+		t.Errorf("stored %d users, want 1", len(users))
+	}
+}
+
+func TestSaveUserOfAnotherTenant(t *testing.T) {
+	repo := inmemory.NewInMemoryUserRepository()
+	service := NewUserService(repo, &recordingPublisher{})
+
+	user, err := service.CreateUser(model.WithTenant(context.Background(), model.DefaultTenantID),
+		UserData{Name: "John", Email: "john@example.com", Age: 30})
+	if err != nil {
+		t.Fatalf("CreateUser() unexpected error = %v", err)
+	}
+
+	var notFound *appErrors.ErrNotFound
+	if err := repo.Save(model.WithTenant(context.Background(), "other"), user); !errors.As(err, &notFound) {
+		t.Errorf("Save() in another tenant error = %v, want not found", err)
+	}
+}
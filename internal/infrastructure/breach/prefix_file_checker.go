@@ -0,0 +1,74 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// prefixLength is the length of the hash prefix naming each range file
+const prefixLength = 5
+
+// PrefixFileChecker checks passwords against a local copy of a breached-password
+// list split into range files, as served by the Have I Been Pwned range API.
+// The SHA-1 hash of a password is split into a 5-character prefix naming the file
+// (e.g. "5BAA6" or "5BAA6.txt") and a suffix looked up in it, one "SUFFIX:COUNT"
+// line per breached password. Only the matching range file is read, and no
+// password or full hash ever leaves the process, so it works offline.
+type PrefixFileChecker struct {
+	dir string
+}
+
+// NewPrefixFileChecker creates a checker reading range files from dir.
+func NewPrefixFileChecker(dir string) *PrefixFileChecker {
+	return &PrefixFileChecker{dir: dir}
+}
+
+// IsBreached reports whether password appears in the breached-password list.
+// A missing range file means no breached password has that prefix.
+func (c *PrefixFileChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:prefixLength], hash[prefixLength:]
+
+	file, err := c.openRange(prefix)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		candidate, count, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if strings.EqualFold(candidate, suffix) && count != "0" {
+			return true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("read breached password range %s: %w", prefix, err)
+	}
+	return false, nil
+}
+
+// openRange opens the range file of a hash prefix, with or without a .txt extension
+func (c *PrefixFileChecker) openRange(prefix string) (*os.File, error) {
+	file, err := os.Open(filepath.Join(c.dir, prefix))
+	if errors.Is(err, fs.ErrNotExist) {
+		file, err = os.Open(filepath.Join(c.dir, prefix+".txt"))
+	}
+	return file, err
+}
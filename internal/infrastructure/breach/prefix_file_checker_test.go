@@ -0,0 +1,40 @@
+package breach
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPrefixFileChecker(t *testing.T) {
+	dir := t.TempDir()
+
+	// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+	writeFile(t, filepath.Join(dir, "5BAA6.txt"), "003D68EB55068C33ACE09247EE4C639306B:3\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:10434004\r\n")
+	// SHA-1 of "letmein" is B7A875FC1EA228B9061041B7CEC4BD3C52AB3CE3, listed with a zero count
+	writeFile(t, filepath.Join(dir, "B7A87"), "5FC1EA228B9061041B7CEC4BD3C52AB3CE3:0\n")
+
+	checker := NewPrefixFileChecker(dir)
+	tests := map[string]bool{
+		"password":                     true,
+		"letmein":                      false,
+		"correct horse battery staple": false,
+	}
+	for password, want := range tests {
+		got, err := checker.IsBreached(context.Background(), password)
+		if err != nil {
+			t.Fatalf("IsBreached(%q) unexpected error = %v", password, err)
+		}
+		if got != want {
+			t.Errorf("IsBreached(%q) = %v, want %v", password, got, want)
+		}
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,48 @@
+package eventbus
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/event"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	"sync"
+)
+
+// Bus is an in-process event.Publisher that delivers events synchronously
+// to the handlers subscribed to their name.
+// Handler failures are logged rather than returned so subscribers never fail
+// the operation that published the event.
+type Bus struct {
+	handlers map[string][]event.Handler
+	mu       sync.RWMutex
+}
+
+// NewBus creates an event bus without subscribers.
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[string][]event.Handler),
+	}
+}
+
+// Subscribe registers a handler for events with the given name.
+func (b *Bus) Subscribe(name string, handler event.Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// Publish delivers the events in order to their handlers.
+func (b *Bus) Publish(ctx context.Context, events ...event.Event) {
+	for _, e := range events {
+		b.mu.RLock()
+		handlers := b.handlers[e.Name()]
+		b.mu.RUnlock()
+
+		for _, handler := range handlers {
+			if err := handler(ctx, e); err != nil {
+				logger.Error(constants.EventHandlerFailed, e.Name(), err)
+			}
+		}
+	}
+}
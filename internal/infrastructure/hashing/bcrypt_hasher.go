@@ -0,0 +1,37 @@
+package hashing
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptHasher is a PasswordHasher using bcrypt.
+// bcrypt only uses the first 72 bytes of a password, so longer passwords
+// should be rejected before hashing.
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates a hasher with the given bcrypt cost,
+// or bcrypt.DefaultCost if cost is 0.
+func NewBcryptHasher(cost int) *BcryptHasher {
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+	return &BcryptHasher{cost: cost}
+}
+
+// Hash returns the bcrypt hash of password.
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", fmt.Errorf("hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// Matches reports whether password matches a bcrypt hash.
+func (h *BcryptHasher) Matches(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
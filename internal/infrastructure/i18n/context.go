@@ -0,0 +1,20 @@
+package i18n
+
+import "context"
+
+// localeKey is the context key of the request locale
+type localeKey struct{}
+
+// WithLocale returns a copy of ctx carrying the locale of the request,
+// so services can write messages in the caller's language.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext returns the locale carried by ctx, or DefaultLocale if none.
+func LocaleFromContext(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
@@ -0,0 +1,142 @@
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is the source language of all messages.
+// The message constants in pkg/constants are both the catalog keys and the
+// English text, so English needs no catalog and is the final fallback.
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var embeddedCatalogs embed.FS
+
+// Bundle holds the message catalogs of every supported locale.
+// Each catalog is a JSON file named after its locale (e.g. "tr.json" or
+// "pt-BR.json") mapping English messages to their translation.
+type Bundle struct {
+	catalogs map[string]map[string]string // Translations per locale
+	locales  []string                     // Supported locales, DefaultLocale first
+	matcher  language.Matcher
+}
+
+// NewBundle loads every *.json catalog in the root of fsys.
+func NewBundle(fsys fs.FS) (*Bundle, error) {
+	files, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bundle{
+		catalogs: make(map[string]map[string]string),
+		locales:  []string{DefaultLocale},
+	}
+	tags := []language.Tag{language.MustParse(DefaultLocale)}
+
+	for _, file := range files {
+		tag, err := language.Parse(strings.TrimSuffix(path.Base(file), ".json"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid catalog name %s: %w", file, err)
+		}
+
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read catalog %s: %w", file, err)
+		}
+		catalog := make(map[string]string)
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("failed to parse catalog %s: %w", file, err)
+		}
+
+		locale := tag.String()
+		if locale == DefaultLocale {
+			continue
+		}
+		b.catalogs[locale] = catalog
+		b.locales = append(b.locales, locale)
+		tags = append(tags, tag)
+	}
+
+	b.matcher = language.NewMatcher(tags)
+	return b, nil
+}
+
+// Locales returns the supported locales, the default locale first.
+func (b *Bundle) Locales() []string {
+	return b.locales
+}
+
+// Match returns the supported locale that best fits an Accept-Language header,
+// or DefaultLocale if none does.
+func (b *Bundle) Match(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLocale
+	}
+
+	_, index, confidence := b.matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLocale
+	}
+	return b.locales[index]
+}
+
+// Translate returns message in the given locale, formatted with args if any.
+// Missing translations fall back from a regional locale to its base language
+// (pt-BR to pt) and finally to the English message itself.
+func (b *Bundle) Translate(locale, message string, args ...any) string {
+	for candidate := locale; candidate != ""; candidate = parentLocale(candidate) {
+		if translated, ok := b.catalogs[candidate][message]; ok {
+			message = translated
+			break
+		}
+	}
+
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+	return message
+}
+
+// parentLocale returns the base language of a regional locale, or an empty string
+func parentLocale(locale string) string {
+	if i := strings.LastIndex(locale, "-"); i > 0 {
+		return locale[:i]
+	}
+	return ""
+}
+
+// Default bundle with the catalogs embedded in the binary
+var (
+	defaultBundle *Bundle
+	defaultOnce   sync.Once
+)
+
+// Default returns the bundle loaded from the catalogs embedded in the binary.
+func Default() *Bundle {
+	defaultOnce.Do(func() {
+		catalogs, err := fs.Sub(embeddedCatalogs, "locales")
+		if err == nil {
+			defaultBundle, err = NewBundle(catalogs)
+		}
+		if err != nil {
+			// The catalogs are compiled in, so this only happens with a broken build
+			panic(fmt.Sprintf("i18n: invalid embedded catalogs: %v", err))
+		}
+	})
+	return defaultBundle
+}
+
+// Translate returns message in the given locale using the default bundle.
+func Translate(locale, message string, args ...any) string {
+	return Default().Translate(locale, message, args...)
+}
@@ -0,0 +1,51 @@
+package i18n
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestBundle(t *testing.T) {
+	bundle, err := NewBundle(fstest.MapFS{
+		"pt.json":    {Data: []byte(`{"User found": "Usuário encontrado", "field '%s' is required": "o campo '%s' é obrigatório"}`)},
+		"pt-BR.json": {Data: []byte(`{"User found": "Usuário localizado"}`)},
+	})
+	if err != nil {
+		t.Fatalf("NewBundle() unexpected error = %v", err)
+	}
+
+	t.Run("Match", func(t *testing.T) {
+		tests := map[string]string{
+			"pt-BR,pt;q=0.9":  "pt-BR",
+			"en-GB":           DefaultLocale,
+			"de-DE, en;q=0.5": "en",
+			"fr":              DefaultLocale,
+			"":                DefaultLocale,
+			"not a header!":   DefaultLocale,
+		}
+		for header, want := range tests {
+			if got := bundle.Match(header); got != want {
+				t.Errorf("Match(%q) = %v, want %v", header, got, want)
+			}
+		}
+	})
+
+	t.Run("Translate With Fallback", func(t *testing.T) {
+		tests := []struct {
+			locale  string
+			message string
+			args    []any
+			want    string
+		}{
+			{"pt-BR", "User found", nil, "Usuário localizado"},
+			{"pt-BR", "field '%s' is required", []any{"name"}, "o campo 'name' é obrigatório"},
+			{"pt-BR", "User deleted successfully", nil, "User deleted successfully"},
+			{"fr", "User found", nil, "User found"},
+		}
+		for _, tt := range tests {
+			if got := bundle.Translate(tt.locale, tt.message, tt.args...); got != tt.want {
+				t.Errorf("Translate(%q, %q) = %v, want %v", tt.locale, tt.message, got, tt.want)
+			}
+		}
+	})
+}
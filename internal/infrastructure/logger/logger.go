@@ -5,6 +5,8 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
+	"sync/atomic"
 	"time"
 )
 
@@ -28,10 +30,21 @@ var logLevelNames = []string{
 	"FATAL",
 }
 
+// ParseLevel converts a level name such as "debug" or "WARN" into a LogLevel
+func ParseLevel(name string) (LogLevel, error) {
+	for i, levelName := range logLevelNames {
+		if strings.EqualFold(name, levelName) {
+			return LogLevel(i), nil
+		}
+	}
+	return INFO, fmt.Errorf("unknown log level: %s", name)
+}
+
 // Logger is a structured logger
 type Logger struct {
-	level  LogLevel
+	level  atomic.Int32
 	prefix string
+	output io.Writer
 	logger *log.Logger
 }
 
@@ -56,11 +69,19 @@ func NewLogger(config Config) *Logger {
 	flags := log.Ldate | log.Ltime
 	logger := log.New(config.Output, config.Prefix, flags)
 
-	return &Logger{
-		level:  config.Level,
+	l := &Logger{
 		prefix: config.Prefix,
+		output: config.Output,
 		logger: logger,
 	}
+	l.level.Store(int32(config.Level))
+	return l
+}
+
+// SetLevel changes the minimum level of logged messages.
+// It is safe to call while the logger is in use.
+func (l *Logger) SetLevel(level LogLevel) {
+	l.level.Store(int32(level))
 }
 
 // formatMessage formats a log message with timestamp and level
@@ -73,7 +94,7 @@ func (l *Logger) formatMessage(level LogLevel, format string, args ...interface{
 
 // log logs a message at the specified level
 func (l *Logger) log(level LogLevel, format string, args ...interface{}) {
-	if level >= l.level {
+	if level >= LogLevel(l.level.Load()) {
 		msg := l.formatMessage(level, format, args...)
 		l.logger.Println(msg)
 	}
@@ -105,6 +126,15 @@ func (l *Logger) Fatal(format string, args ...interface{}) {
 	os.Exit(1)
 }
 
+// Sync flushes any buffered log output.
+// It is a no-op for outputs that do not support syncing.
+func (l *Logger) Sync() error {
+	if syncer, ok := l.output.(interface{ Sync() error }); ok {
+		return syncer.Sync()
+	}
+	return nil
+}
+
 // Default global logger
 var defaultLogger = NewLogger(DefaultConfig())
 
@@ -113,6 +143,11 @@ func SetDefaultLogger(logger *Logger) {
 	defaultLogger = logger
 }
 
+// SetLevel changes the minimum level of the default logger
+func SetLevel(level LogLevel) {
+	defaultLogger.SetLevel(level)
+}
+
 // Global logging functions
 
 // Debug logs a debug message using the default logger
@@ -139,3 +174,8 @@ func Error(format string, args ...interface{}) {
 func Fatal(format string, args ...interface{}) {
 	defaultLogger.Fatal(format, args...)
 }
+
+// Sync flushes any buffered output of the default logger
+func Sync() error {
+	return defaultLogger.Sync()
+}
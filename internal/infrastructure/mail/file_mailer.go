@@ -0,0 +1,55 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// FileMailer is a Mailer that writes each message to an .eml file in a directory
+// instead of sending it. The files open in most mail clients, which makes it
+// useful for local development and manual testing.
+type FileMailer struct {
+	dir string
+	seq atomic.Int64 // Distinguishes messages written within the same instant
+}
+
+// NewFileMailer creates a mailer writing messages to dir, creating it if needed.
+func NewFileMailer(dir string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create mail directory: %w", err)
+	}
+	return &FileMailer{dir: dir}, nil
+}
+
+// Send writes the message to a new file.
+func (m *FileMailer) Send(ctx context.Context, message service.MailMessage) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	name := fmt.Sprintf("%s-%03d.eml", now.Format("20060102T150405.000000000"), m.seq.Add(1)%1000)
+	path := filepath.Join(m.dir, name)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "To: %s\r\n", message.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", message.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(message.Body)
+	b.WriteString("\r\n")
+
+	if err := os.WriteFile(path, []byte(b.String()), 0o640); err != nil {
+		return fmt.Errorf("write mail file: %w", err)
+	}
+	logger.Info(constants.MailWritten, message.To, path)
+	return nil
+}
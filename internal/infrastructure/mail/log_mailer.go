@@ -0,0 +1,26 @@
+package mail
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+)
+
+// LogMailer is a Mailer that writes messages to the application log instead of sending them.
+// It suits local development, where links can be copied from the log.
+type LogMailer struct{}
+
+// NewLogMailer creates a mailer writing messages to the log.
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+// Send writes the message to the log.
+func (m *LogMailer) Send(ctx context.Context, message service.MailMessage) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	logger.Info(constants.MailLogged, message.To, message.Subject, message.Body)
+	return nil
+}
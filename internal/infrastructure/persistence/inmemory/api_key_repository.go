@@ -0,0 +1,129 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"slices"
+	"sync"
+	"time"
+)
+
+// InMemoryAPIKeyRepository implements the APIKeyRepository interface with an in-memory storage.
+// Keys are not shared between instances.
+type InMemoryAPIKeyRepository struct {
+	keys   map[int]*model.APIKey
+	nextID int
+	mu     sync.RWMutex
+}
+
+// NewInMemoryAPIKeyRepository creates a new instance of the in-memory API key repository.
+func NewInMemoryAPIKeyRepository() repository.APIKeyRepository {
+	return &InMemoryAPIKeyRepository{
+		keys:   make(map[int]*model.APIKey),
+		nextID: 1,
+	}
+}
+
+// FindAll retrieves all keys, including revoked and expired ones, ordered by ID.
+func (r *InMemoryAPIKeyRepository) FindAll(ctx context.Context) ([]*model.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]*model.APIKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		copied := *key
+		keys = append(keys, &copied)
+	}
+	slices.SortFunc(keys, func(a, b *model.APIKey) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return keys, nil
+}
+
+// FindByID retrieves a key by its identifier.
+func (r *InMemoryAPIKeyRepository) FindByID(ctx context.Context, id int) (*model.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, exists := r.keys[id]
+	if !exists {
+		return nil, errors.New("API key not found")
+	}
+
+	// Return a copy so callers cannot modify stored state without saving.
+	// Scopes are never modified, so they can be shared.
+	copied := *key
+	return &copied, nil
+}
+
+// FindByPrefix retrieves a key by its public prefix.
+func (r *InMemoryAPIKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, key := range r.keys {
+		if key.Prefix() == prefix {
+			copied := *key
+			return &copied, nil
+		}
+	}
+
+	return nil, errors.New("API key not found")
+}
+
+// Save persists a key (create or update), assigning an ID to new keys.
+func (r *InMemoryAPIKeyRepository) Save(ctx context.Context, key *model.APIKey) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// If this is a new key (ID == 0), assign a new ID
+	if key.ID() == 0 {
+		if err := key.AssignID(r.nextID); err != nil {
+			return err
+		}
+		r.nextID++
+	}
+
+	copied := *key
+	r.keys[key.ID()] = &copied
+	return nil
+}
+
+// MarkUsed records that a key was used at the given time, leaving the rest
+// of the stored key untouched.
+func (r *InMemoryAPIKeyRepository) MarkUsed(ctx context.Context, id int, at time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, exists := r.keys[id]
+	if !exists {
+		return errors.New("API key not found")
+	}
+
+	key.MarkUsed(at)
+	return nil
+}
@@ -0,0 +1,47 @@
+package inmemory
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"sync"
+)
+
+// InMemoryAuditRepository implements the AuditRepository interface with an in-memory storage.
+// Events are lost on restart, so it is only suitable for development and testing.
+type InMemoryAuditRepository struct {
+	events []*model.AuditEvent
+	mu     sync.RWMutex
+}
+
+// NewInMemoryAuditRepository creates a new instance of the in-memory audit repository.
+func NewInMemoryAuditRepository() repository.AuditRepository {
+	return &InMemoryAuditRepository{}
+}
+
+// Append stores a new audit event.
+func (r *InMemoryAuditRepository) Append(ctx context.Context, event *model.AuditEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+	return nil
+}
+
+// FindAll retrieves all audit events in the order they were recorded.
+func (r *InMemoryAuditRepository) FindAll(ctx context.Context) ([]*model.AuditEvent, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	events := make([]*model.AuditEvent, len(r.events))
+	copy(events, r.events)
+	return events, nil
+}
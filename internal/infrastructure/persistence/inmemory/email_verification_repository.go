@@ -0,0 +1,69 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"sync"
+)
+
+// InMemoryEmailVerificationRepository implements the EmailVerificationRepository interface with an in-memory storage.
+// Verifications are not shared between instances.
+type InMemoryEmailVerificationRepository struct {
+	verifications map[int]*model.EmailVerification
+	mu            sync.RWMutex
+}
+
+// NewInMemoryEmailVerificationRepository creates a new instance of the in-memory email verification repository.
+func NewInMemoryEmailVerificationRepository() repository.EmailVerificationRepository {
+	return &InMemoryEmailVerificationRepository{
+		verifications: make(map[int]*model.EmailVerification),
+	}
+}
+
+// FindByUserID retrieves the outstanding verification of a user.
+func (r *InMemoryEmailVerificationRepository) FindByUserID(ctx context.Context, userID int) (*model.EmailVerification, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	verification, exists := r.verifications[userID]
+	if !exists {
+		return nil, errors.New("email verification not found")
+	}
+
+	// Return a copy so callers cannot modify stored state without saving
+	copied := *verification
+	return &copied, nil
+}
+
+// Save persists a verification, replacing any previous one of the same user.
+func (r *InMemoryEmailVerificationRepository) Save(ctx context.Context, verification *model.EmailVerification) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	copied := *verification
+	r.verifications[verification.UserID()] = &copied
+	return nil
+}
+
+// Delete removes the outstanding verification of a user.
+func (r *InMemoryEmailVerificationRepository) Delete(ctx context.Context, userID int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.verifications, userID)
+	return nil
+}
@@ -0,0 +1,127 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"slices"
+	"sync"
+)
+
+// InMemoryInvitationRepository implements the InvitationRepository interface with an in-memory storage.
+// Invitations are not shared between instances.
+type InMemoryInvitationRepository struct {
+	invitations map[int]*model.Invitation
+	nextID      int
+	mu          sync.RWMutex
+}
+
+// NewInMemoryInvitationRepository creates a new instance of the in-memory invitation repository.
+func NewInMemoryInvitationRepository() repository.InvitationRepository {
+	return &InMemoryInvitationRepository{
+		invitations: make(map[int]*model.Invitation),
+		nextID:      1,
+	}
+}
+
+// FindByID retrieves an invitation by its identifier.
+func (r *InMemoryInvitationRepository) FindByID(ctx context.Context, id int) (*model.Invitation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	invitation, exists := r.invitations[id]
+	if !exists {
+		return nil, errors.New("invitation not found")
+	}
+
+	// Return a copy so callers cannot modify stored state without saving
+	copied := *invitation
+	return &copied, nil
+}
+
+// FindByTokenHash retrieves the invitation whose token has the given hash.
+func (r *InMemoryInvitationRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*model.Invitation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, invitation := range r.invitations {
+		if invitation.TokenHash() == tokenHash {
+			copied := *invitation
+			return &copied, nil
+		}
+	}
+
+	return nil, errors.New("invitation not found")
+}
+
+// FindByTeamID retrieves every invitation to a team, including answered ones, ordered by ID.
+func (r *InMemoryInvitationRepository) FindByTeamID(ctx context.Context, teamID int) ([]*model.Invitation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var invitations []*model.Invitation
+	for _, invitation := range r.invitations {
+		if invitation.TeamID() == teamID {
+			copied := *invitation
+			invitations = append(invitations, &copied)
+		}
+	}
+	slices.SortFunc(invitations, func(a, b *model.Invitation) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return invitations, nil
+}
+
+// Save persists an invitation (create or update), assigning an ID to new invitations.
+func (r *InMemoryInvitationRepository) Save(ctx context.Context, invitation *model.Invitation) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// If this is a new invitation (ID == 0), assign a new ID
+	if invitation.ID() == 0 {
+		if err := invitation.AssignID(r.nextID); err != nil {
+			return err
+		}
+		r.nextID++
+	}
+
+	copied := *invitation
+	r.invitations[invitation.ID()] = &copied
+	return nil
+}
+
+// Delete removes an invitation.
+func (r *InMemoryInvitationRepository) Delete(ctx context.Context, id int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.invitations[id]; !exists {
+		return errors.New("invitation not found")
+	}
+
+	delete(r.invitations, id)
+	return nil
+}
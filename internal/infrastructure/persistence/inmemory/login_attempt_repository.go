@@ -0,0 +1,92 @@
+package inmemory
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"sync"
+	"time"
+)
+
+// InMemoryLoginAttemptRepository implements the LoginAttemptRepository interface with an in-memory storage.
+// Attempts are not shared between instances.
+type InMemoryLoginAttemptRepository struct {
+	attempts map[string]*model.LoginAttempts
+	mu       sync.RWMutex
+}
+
+// NewInMemoryLoginAttemptRepository creates a new instance of the in-memory login attempt repository.
+func NewInMemoryLoginAttemptRepository() repository.LoginAttemptRepository {
+	return &InMemoryLoginAttemptRepository{
+		attempts: make(map[string]*model.LoginAttempts),
+	}
+}
+
+// FindByKey retrieves the attempts recorded for a subject key.
+func (r *InMemoryLoginAttemptRepository) FindByKey(ctx context.Context, key string) (*model.LoginAttempts, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	attempts, exists := r.attempts[key]
+	if !exists {
+		return model.NewLoginAttempts(key), nil
+	}
+
+	// Return a copy so callers cannot modify stored state
+	copied := *attempts
+	return &copied, nil
+}
+
+// RecordFailure registers a failed attempt for a subject key and applies the policy under the lock.
+func (r *InMemoryLoginAttemptRepository) RecordFailure(ctx context.Context, key string, now time.Time, policy model.LockoutPolicy) (*model.LoginAttempts, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempts, exists := r.attempts[key]
+	if !exists {
+		attempts = model.NewLoginAttempts(key)
+		r.attempts[key] = attempts
+	}
+	locked := attempts.RecordFailure(now, policy)
+
+	copied := *attempts
+	return &copied, locked, nil
+}
+
+// DeleteExpired removes the attempts that no longer affect future attempts under the policy.
+func (r *InMemoryLoginAttemptRepository) DeleteExpired(ctx context.Context, now time.Time, policy model.LockoutPolicy) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, attempts := range r.attempts {
+		if attempts.IsExpired(now, policy) {
+			delete(r.attempts, key)
+		}
+	}
+	return nil
+}
+
+// Delete removes the attempts recorded for a subject key.
+func (r *InMemoryLoginAttemptRepository) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.attempts, key)
+	return nil
+}
@@ -0,0 +1,72 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"sync"
+)
+
+// InMemoryMFACredentialRepository implements the MFACredentialRepository interface with an in-memory storage.
+// Credentials are not shared between instances.
+type InMemoryMFACredentialRepository struct {
+	credentials map[int]*model.MFACredential
+	mu          sync.RWMutex
+}
+
+// NewInMemoryMFACredentialRepository creates a new instance of the in-memory MFA credential repository.
+func NewInMemoryMFACredentialRepository() repository.MFACredentialRepository {
+	return &InMemoryMFACredentialRepository{
+		credentials: make(map[int]*model.MFACredential),
+	}
+}
+
+// FindByUserID retrieves the credential of a user.
+func (r *InMemoryMFACredentialRepository) FindByUserID(ctx context.Context, userID int) (*model.MFACredential, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	credential, exists := r.credentials[userID]
+	if !exists {
+		return nil, errors.New("MFA credential not found")
+	}
+
+	// Return a copy so callers cannot modify stored state without saving
+	return copyMFACredential(credential), nil
+}
+
+// Save persists a credential, replacing any previous one of the same user.
+func (r *InMemoryMFACredentialRepository) Save(ctx context.Context, credential *model.MFACredential) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.credentials[credential.UserID()] = copyMFACredential(credential)
+	return nil
+}
+
+// Delete removes the credential of a user.
+func (r *InMemoryMFACredentialRepository) Delete(ctx context.Context, userID int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.credentials, userID)
+	return nil
+}
+
+// copyMFACredential returns a deep copy of a credential, including its recovery codes
+func copyMFACredential(c *model.MFACredential) *model.MFACredential {
+	return model.RestoreMFACredential(c.UserID(), c.Secret(), c.CreatedAt(), c.ConfirmedAt(), c.LastCounter(), c.RecoveryCodes())
+}
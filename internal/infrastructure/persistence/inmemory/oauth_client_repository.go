@@ -0,0 +1,125 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"slices"
+	"sync"
+)
+
+// InMemoryOAuthClientRepository implements the OAuthClientRepository interface with an in-memory storage.
+// Clients are not shared between instances.
+type InMemoryOAuthClientRepository struct {
+	clients map[int]*model.OAuthClient
+	nextID  int
+	mu      sync.RWMutex
+}
+
+// NewInMemoryOAuthClientRepository creates a new instance of the in-memory OAuth client repository.
+func NewInMemoryOAuthClientRepository() repository.OAuthClientRepository {
+	return &InMemoryOAuthClientRepository{
+		clients: make(map[int]*model.OAuthClient),
+		nextID:  1,
+	}
+}
+
+// FindAll retrieves all clients, ordered by ID.
+func (r *InMemoryOAuthClientRepository) FindAll(ctx context.Context) ([]*model.OAuthClient, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	clients := make([]*model.OAuthClient, 0, len(r.clients))
+	for _, client := range r.clients {
+		copied := *client
+		clients = append(clients, &copied)
+	}
+	slices.SortFunc(clients, func(a, b *model.OAuthClient) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return clients, nil
+}
+
+// FindByID retrieves a client by its identifier.
+func (r *InMemoryOAuthClientRepository) FindByID(ctx context.Context, id int) (*model.OAuthClient, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	client, exists := r.clients[id]
+	if !exists {
+		return nil, errors.New("OAuth client not found")
+	}
+
+	// Return a copy so callers cannot modify stored state without saving.
+	// Scopes are never modified, so they can be shared.
+	copied := *client
+	return &copied, nil
+}
+
+// FindByClientID retrieves a client by the public identifier it authenticates with.
+func (r *InMemoryOAuthClientRepository) FindByClientID(ctx context.Context, clientID string) (*model.OAuthClient, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, client := range r.clients {
+		if client.ClientID() == clientID {
+			copied := *client
+			return &copied, nil
+		}
+	}
+
+	return nil, errors.New("OAuth client not found")
+}
+
+// Save persists a client (create or update), assigning an ID to new clients.
+func (r *InMemoryOAuthClientRepository) Save(ctx context.Context, client *model.OAuthClient) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// If this is a new client (ID == 0), assign a new ID
+	if client.ID() == 0 {
+		if err := client.AssignID(r.nextID); err != nil {
+			return err
+		}
+		r.nextID++
+	}
+
+	copied := *client
+	r.clients[client.ID()] = &copied
+	return nil
+}
+
+// Delete removes a client.
+func (r *InMemoryOAuthClientRepository) Delete(ctx context.Context, id int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.clients[id]; !exists {
+		return errors.New("OAuth client not found")
+	}
+	delete(r.clients, id)
+	return nil
+}
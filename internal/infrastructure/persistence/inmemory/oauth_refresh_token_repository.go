@@ -0,0 +1,81 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"sync"
+	"time"
+)
+
+// InMemoryOAuthRefreshTokenRepository implements the OAuthRefreshTokenRepository interface with an in-memory storage.
+// Tokens are not shared between instances.
+type InMemoryOAuthRefreshTokenRepository struct {
+	tokens map[string]*model.OAuthRefreshToken
+	mu     sync.RWMutex
+}
+
+// NewInMemoryOAuthRefreshTokenRepository creates a new instance of the in-memory OAuth refresh token repository.
+func NewInMemoryOAuthRefreshTokenRepository() repository.OAuthRefreshTokenRepository {
+	return &InMemoryOAuthRefreshTokenRepository{
+		tokens: make(map[string]*model.OAuthRefreshToken),
+	}
+}
+
+// FindByTokenHash retrieves the refresh token with the given hash.
+func (r *InMemoryOAuthRefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*model.OAuthRefreshToken, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	token, exists := r.tokens[tokenHash]
+	if !exists {
+		return nil, errors.New("refresh token not found")
+	}
+
+	copied := *token
+	return &copied, nil
+}
+
+// Save persists a refresh token (create or update).
+// Expired tokens are dropped on the way, as they can never be used again.
+func (r *InMemoryOAuthRefreshTokenRepository) Save(ctx context.Context, token *model.OAuthRefreshToken) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for hash, stored := range r.tokens {
+		if !now.Before(stored.ExpiresAt()) {
+			delete(r.tokens, hash)
+		}
+	}
+
+	copied := *token
+	r.tokens[token.TokenHash()] = &copied
+	return nil
+}
+
+// DeleteByClientID removes every refresh token issued to a client.
+func (r *InMemoryOAuthRefreshTokenRepository) DeleteByClientID(ctx context.Context, clientID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for hash, token := range r.tokens {
+		if token.ClientID() == clientID {
+			delete(r.tokens, hash)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"slices"
+	"sync"
+)
+
+// InMemoryOrganizationRepository implements the OrganizationRepository interface with an in-memory storage.
+// Organizations of all tenants share one store; every method filters them by
+// the tenant of its context.
+type InMemoryOrganizationRepository struct {
+	organizations map[int]*model.Organization
+	nextID        int
+	mu            sync.RWMutex
+}
+
+// NewInMemoryOrganizationRepository creates a new instance of the in-memory organization repository.
+func NewInMemoryOrganizationRepository() repository.OrganizationRepository {
+	return &InMemoryOrganizationRepository{
+		organizations: make(map[int]*model.Organization),
+		nextID:        1,
+	}
+}
+
+// FindByID retrieves an organization of the tenant by its identifier.
+func (r *InMemoryOrganizationRepository) FindByID(ctx context.Context, id int) (*model.Organization, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	organization, exists := r.organizations[id]
+	if !exists || organization.TenantID() != tenantID {
+		return nil, errors.New("organization not found")
+	}
+
+	// Return a copy so callers cannot modify stored state without saving.
+	// Member lists are replaced rather than modified, so they can be shared.
+	copied := *organization
+	return &copied, nil
+}
+
+// FindByMember retrieves every organization of the tenant a user is a member of, ordered by ID.
+func (r *InMemoryOrganizationRepository) FindByMember(ctx context.Context, userID int) ([]*model.Organization, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var organizations []*model.Organization
+	for _, organization := range r.organizations {
+		if _, ok := organization.Member(userID); ok && organization.TenantID() == tenantID {
+			copied := *organization
+			organizations = append(organizations, &copied)
+		}
+	}
+	slices.SortFunc(organizations, func(a, b *model.Organization) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return organizations, nil
+}
+
+// Save persists an organization of the tenant (create or update), assigning an ID to new organizations.
+func (r *InMemoryOrganizationRepository) Save(ctx context.Context, organization *model.Organization) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	if organization.TenantID() != tenantID {
+		return errors.New("organization not found")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// If this is a new organization (ID == 0), assign a new ID
+	if organization.ID() == 0 {
+		if err := organization.AssignID(r.nextID); err != nil {
+			return err
+		}
+		r.nextID++
+	}
+
+	copied := *organization
+	r.organizations[organization.ID()] = &copied
+	return nil
+}
+
+// Delete removes an organization of the tenant.
+func (r *InMemoryOrganizationRepository) Delete(ctx context.Context, id int) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if organization, exists := r.organizations[id]; !exists || organization.TenantID() != tenantID {
+		return errors.New("organization not found")
+	}
+
+	delete(r.organizations, id)
+	return nil
+}
@@ -0,0 +1,88 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"sync"
+)
+
+// InMemoryPasswordResetRepository implements the PasswordResetRepository interface with an in-memory storage.
+// Resets are not shared between instances.
+type InMemoryPasswordResetRepository struct {
+	resets map[int]*model.PasswordReset
+	mu     sync.RWMutex
+}
+
+// NewInMemoryPasswordResetRepository creates a new instance of the in-memory password reset repository.
+func NewInMemoryPasswordResetRepository() repository.PasswordResetRepository {
+	return &InMemoryPasswordResetRepository{
+		resets: make(map[int]*model.PasswordReset),
+	}
+}
+
+// FindByTokenHash retrieves the reset whose token has the given hash.
+func (r *InMemoryPasswordResetRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*model.PasswordReset, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, reset := range r.resets {
+		if reset.TokenHash() == tokenHash {
+			// Return a copy so callers cannot modify stored state without saving
+			copied := *reset
+			return &copied, nil
+		}
+	}
+
+	return nil, errors.New("password reset not found")
+}
+
+// FindByUserID retrieves the outstanding reset of a user.
+func (r *InMemoryPasswordResetRepository) FindByUserID(ctx context.Context, userID int) (*model.PasswordReset, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reset, exists := r.resets[userID]
+	if !exists {
+		return nil, errors.New("password reset not found")
+	}
+
+	copied := *reset
+	return &copied, nil
+}
+
+// Save persists a reset, replacing any previous one of the same user.
+func (r *InMemoryPasswordResetRepository) Save(ctx context.Context, reset *model.PasswordReset) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	copied := *reset
+	r.resets[reset.UserID()] = &copied
+	return nil
+}
+
+// Delete removes the outstanding reset of a user.
+func (r *InMemoryPasswordResetRepository) Delete(ctx context.Context, userID int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.resets, userID)
+	return nil
+}
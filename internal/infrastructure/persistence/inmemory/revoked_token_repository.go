@@ -0,0 +1,56 @@
+package inmemory
+
+import (
+	"context"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"sync"
+	"time"
+)
+
+// InMemoryRevokedTokenRepository implements the RevokedTokenRepository interface with an in-memory storage.
+// Revocations are not shared between instances.
+type InMemoryRevokedTokenRepository struct {
+	tokens map[string]time.Time // Expiry per revoked token ID
+	mu     sync.RWMutex
+}
+
+// NewInMemoryRevokedTokenRepository creates a new instance of the in-memory revoked token repository.
+func NewInMemoryRevokedTokenRepository() repository.RevokedTokenRepository {
+	return &InMemoryRevokedTokenRepository{
+		tokens: make(map[string]time.Time),
+	}
+}
+
+// Add records a revoked token until it expires.
+// Tokens that have expired since they were revoked are dropped on the way.
+func (r *InMemoryRevokedTokenRepository) Add(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for id, expiry := range r.tokens {
+		if !now.Before(expiry) {
+			delete(r.tokens, id)
+		}
+	}
+
+	r.tokens[tokenID] = expiresAt
+	return nil
+}
+
+// Contains reports whether a token was revoked.
+func (r *InMemoryRevokedTokenRepository) Contains(ctx context.Context, tokenID string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.tokens[tokenID]
+	return exists, nil
+}
@@ -1,12 +1,52 @@
 package inmemory
 
 import (
+	"context"
 	"errors"
 	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
 	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"time"
 )
 
+// sampleTenantID is the tenant of the sample user outside the default tenant
+const sampleTenantID = "acme"
+
+// GetSampleTenants creates the default tenant and a sample customer tenant.
+func GetSampleTenants() ([]*model.Tenant, error) {
+	now := time.Now().UTC()
+	defaultTenant, err := model.NewTenant(model.DefaultTenantID, "Default", now)
+	if err != nil {
+		return nil, err
+	}
+
+	acme, err := model.NewTenant(sampleTenantID, "Acme Corporation", now)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*model.Tenant{defaultTenant, acme}, nil
+}
+
+// InitializeWithSampleTenants populates the repository with the sample tenants.
+func InitializeWithSampleTenants(repo repository.TenantRepository) error {
+	tenants, err := GetSampleTenants()
+	if err != nil {
+		return err
+	}
+
+	for _, tenant := range tenants {
+		if err := repo.Save(context.Background(), tenant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetSampleUsers creates a set of sample users for development and testing.
+// The first three belong to the default tenant; the fourth, of the "acme"
+// tenant, shares an email address with the second, as addresses are only
+// unique within a tenant. The fifth is the account of the demo "admin"
+// credentials, in the default tenant.
 func GetSampleUsers() ([]*model.User, error) {
 	// Create sample users
 	user1, err := model.NewUserWithID(1, "John Doe", "john@example.com", 30)
@@ -24,7 +64,56 @@ func GetSampleUsers() ([]*model.User, error) {
 		return nil, err
 	}
 
-	return []*model.User{user1, user2, user3}, nil
+	user4, err := model.NewUserWithID(4, "Jane Cooper", "jane@example.com", 34)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := model.NewUserWithID(5, "Administrator", "admin@example.com", 40)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sample tenants
+	for _, user := range []*model.User{user1, user2, user3, admin} {
+		if err := user.AssignTenant(model.DefaultTenantID); err != nil {
+			return nil, err
+		}
+	}
+	if err := user4.AssignTenant(sampleTenantID); err != nil {
+		return nil, err
+	}
+
+	// Sample profile details
+	if err := user1.SetTimezone("America/New_York"); err != nil {
+		return nil, err
+	}
+	if err := user1.SetPhoneNumber("+14155552671"); err != nil {
+		return nil, err
+	}
+	if err := user2.SetLocale("en-GB"); err != nil {
+		return nil, err
+	}
+	if err := user2.SetTimezone("Europe/London"); err != nil {
+		return nil, err
+	}
+	if err := user3.SetDisplayName("Bobby"); err != nil {
+		return nil, err
+	}
+
+	// Sample lifecycle states; the third user has not been activated yet
+	user1.RestoreStatus(model.UserStatusActive)
+	user2.RestoreStatus(model.UserStatusActive)
+	user4.RestoreStatus(model.UserStatusActive)
+	admin.RestoreStatus(model.UserStatusActive)
+
+	// The active users have verified their email addresses
+	user1.RestoreEmailVerifiedAt(user1.CreatedAt())
+	user2.RestoreEmailVerifiedAt(user2.CreatedAt())
+	user4.RestoreEmailVerifiedAt(user4.CreatedAt())
+	admin.RestoreEmailVerifiedAt(admin.CreatedAt())
+
+	return []*model.User{user1, user2, user3, user4, admin}, nil
 }
 
 // InitializeWithUsers initializes the repository with a given set of users.
@@ -0,0 +1,120 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"slices"
+	"sync"
+	"time"
+)
+
+// InMemorySessionRepository implements the SessionRepository interface with an in-memory storage.
+// Sessions are not shared between instances.
+type InMemorySessionRepository struct {
+	sessions map[int]*model.Session
+	nextID   int
+	mu       sync.RWMutex
+}
+
+// NewInMemorySessionRepository creates a new instance of the in-memory session repository.
+func NewInMemorySessionRepository() repository.SessionRepository {
+	return &InMemorySessionRepository{
+		sessions: make(map[int]*model.Session),
+		nextID:   1,
+	}
+}
+
+// FindByID retrieves a session by its identifier.
+func (r *InMemorySessionRepository) FindByID(ctx context.Context, id int) (*model.Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	session, exists := r.sessions[id]
+	if !exists {
+		return nil, errors.New("session not found")
+	}
+
+	// Return a copy so callers cannot modify stored state without saving.
+	// AMR and scopes are never modified, so they can be shared.
+	copied := *session
+	return &copied, nil
+}
+
+// FindByRefreshTokenHash retrieves the session whose current refresh token has the given hash.
+func (r *InMemorySessionRepository) FindByRefreshTokenHash(ctx context.Context, tokenHash string) (*model.Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, session := range r.sessions {
+		if session.RefreshTokenHash() == tokenHash {
+			copied := *session
+			return &copied, nil
+		}
+	}
+
+	return nil, errors.New("session not found")
+}
+
+// FindByUserID retrieves every session of a user, including revoked ones, ordered by ID.
+func (r *InMemorySessionRepository) FindByUserID(ctx context.Context, userID int) ([]*model.Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var sessions []*model.Session
+	for _, session := range r.sessions {
+		if session.UserID() == userID {
+			copied := *session
+			sessions = append(sessions, &copied)
+		}
+	}
+	slices.SortFunc(sessions, func(a, b *model.Session) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return sessions, nil
+}
+
+// Save persists a session (create or update), assigning an ID to new sessions.
+// Expired sessions are dropped on the way, as they can never be used again.
+func (r *InMemorySessionRepository) Save(ctx context.Context, session *model.Session) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for id, stored := range r.sessions {
+		if !now.Before(stored.ExpiresAt()) {
+			delete(r.sessions, id)
+		}
+	}
+
+	// If this is a new session (ID == 0), assign a new ID
+	if session.ID() == 0 {
+		if err := session.AssignID(r.nextID); err != nil {
+			return err
+		}
+		r.nextID++
+	}
+
+	copied := *session
+	r.sessions[session.ID()] = &copied
+	return nil
+}
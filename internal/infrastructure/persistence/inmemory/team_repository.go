@@ -0,0 +1,109 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"slices"
+	"sync"
+)
+
+// InMemoryTeamRepository implements the TeamRepository interface with an in-memory storage.
+// Teams are not shared between instances.
+type InMemoryTeamRepository struct {
+	teams  map[int]*model.Team
+	nextID int
+	mu     sync.RWMutex
+}
+
+// NewInMemoryTeamRepository creates a new instance of the in-memory team repository.
+func NewInMemoryTeamRepository() repository.TeamRepository {
+	return &InMemoryTeamRepository{
+		teams:  make(map[int]*model.Team),
+		nextID: 1,
+	}
+}
+
+// FindByID retrieves a team by its identifier.
+func (r *InMemoryTeamRepository) FindByID(ctx context.Context, id int) (*model.Team, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	team, exists := r.teams[id]
+	if !exists {
+		return nil, errors.New("team not found")
+	}
+
+	// Return a copy so callers cannot modify stored state without saving.
+	// Member lists are replaced rather than modified, so they can be shared.
+	copied := *team
+	return &copied, nil
+}
+
+// FindByOrganizationID retrieves every team of an organization, ordered by ID.
+func (r *InMemoryTeamRepository) FindByOrganizationID(ctx context.Context, organizationID int) ([]*model.Team, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var teams []*model.Team
+	for _, team := range r.teams {
+		if team.OrganizationID() == organizationID {
+			copied := *team
+			teams = append(teams, &copied)
+		}
+	}
+	slices.SortFunc(teams, func(a, b *model.Team) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return teams, nil
+}
+
+// Save persists a team (create or update), assigning an ID to new teams.
+func (r *InMemoryTeamRepository) Save(ctx context.Context, team *model.Team) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// If this is a new team (ID == 0), assign a new ID
+	if team.ID() == 0 {
+		if err := team.AssignID(r.nextID); err != nil {
+			return err
+		}
+		r.nextID++
+	}
+
+	copied := *team
+	r.teams[team.ID()] = &copied
+	return nil
+}
+
+// Delete removes a team.
+func (r *InMemoryTeamRepository) Delete(ctx context.Context, id int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.teams[id]; !exists {
+		return errors.New("team not found")
+	}
+
+	delete(r.teams, id)
+	return nil
+}
@@ -0,0 +1,75 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	"slices"
+	"sync"
+)
+
+// InMemoryTenantRepository implements the TenantRepository interface with an in-memory storage.
+// Tenants are not shared between instances.
+type InMemoryTenantRepository struct {
+	tenants map[string]*model.Tenant
+	mu      sync.RWMutex
+}
+
+// NewInMemoryTenantRepository creates a new instance of the in-memory tenant repository.
+func NewInMemoryTenantRepository() repository.TenantRepository {
+	return &InMemoryTenantRepository{
+		tenants: make(map[string]*model.Tenant),
+	}
+}
+
+// FindByID retrieves a tenant by its identifier.
+func (r *InMemoryTenantRepository) FindByID(ctx context.Context, id string) (*model.Tenant, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenant, exists := r.tenants[id]
+	if !exists {
+		return nil, errors.New("tenant not found")
+	}
+
+	return tenant, nil
+}
+
+// FindAll retrieves all tenants, ordered by ID.
+func (r *InMemoryTenantRepository) FindAll(ctx context.Context) ([]*model.Tenant, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenants := make([]*model.Tenant, 0, len(r.tenants))
+	for _, tenant := range r.tenants {
+		tenants = append(tenants, tenant)
+	}
+	slices.SortFunc(tenants, func(a, b *model.Tenant) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return tenants, nil
+}
+
+// Save creates or updates a tenant.
+func (r *InMemoryTenantRepository) Save(ctx context.Context, tenant *model.Tenant) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tenants[tenant.ID()] = tenant
+	return nil
+}
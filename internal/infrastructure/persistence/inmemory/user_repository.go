@@ -3,13 +3,17 @@ package inmemory
 import (
 	"context"
 	"errors"
+	"fmt"
 	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
 	"mcanvr/example-golang-api-with-fiber/internal/domain/repository"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
 	"sync"
 )
 
 // InMemoryUserRepository implements the UserRepository interface with an in-memory storage.
 // This is primarily used for testing or small applications.
+// Users of all tenants share one store and one ID sequence; every method
+// filters them by the tenant of its context.
 type InMemoryUserRepository struct {
 	users  map[int]*model.User
 	nextID int
@@ -25,12 +29,13 @@ func NewInMemoryUserRepository() repository.UserRepository {
 }
 
 // Initialize populates the repository with initial data.
+// Users must already belong to a tenant.
 func (r *InMemoryUserRepository) Initialize(users []*model.User) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	for _, user := range users {
-		r.users[user.ID()] = user
+		r.users[user.ID()] = copyUser(user)
 		if user.ID() >= r.nextID {
 			r.nextID = user.ID() + 1
 		}
@@ -39,65 +44,90 @@ func (r *InMemoryUserRepository) Initialize(users []*model.User) {
 
 // FindByID locates a user by their ID.
 func (r *InMemoryUserRepository) FindByID(ctx context.Context, id int) (*model.User, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	user, exists := r.users[id]
-	if !exists {
+	if !exists || user.TenantID() != tenantID {
 		return nil, errors.New("user not found")
 	}
 
-	return user, nil
+	return copyUser(user), nil
 }
 
-// FindAll retrieves all users.
+// FindAll retrieves all users of the tenant.
 func (r *InMemoryUserRepository) FindAll(ctx context.Context) ([]*model.User, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	users := make([]*model.User, 0, len(r.users))
 	for _, user := range r.users {
-		users = append(users, user)
+		if user.TenantID() == tenantID {
+			users = append(users, copyUser(user))
+		}
 	}
 
 	return users, nil
 }
 
-// Save creates or updates a user.
+// Save creates or updates a user. New users join the tenant of the context;
+// users of other tenants cannot be saved. The email address is checked to be
+// unique within the tenant under the same lock as the write, so concurrent
+// saves cannot both take it.
 func (r *InMemoryUserRepository) Save(ctx context.Context, user *model.User) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	// If this is a new user (ID == 0), assign a new ID
+	if user.ID() != 0 && user.TenantID() != tenantID {
+		return &appErrors.ErrNotFound{Resource: "user", ID: user.ID()}
+	}
+	for _, other := range r.users {
+		if other.ID() != user.ID() && other.TenantID() == tenantID && other.Email().Equals(user.Email()) {
+			return fmt.Errorf("%w: %s", model.ErrEmailInUse, user.Email())
+		}
+	}
+
+	// If this is a new user (ID == 0), assign a new ID in the tenant
 	if user.ID() == 0 {
-		// Create a new user with the assigned ID
-		newUser, err := model.NewUserWithID(r.nextID, user.Name(), user.Email(), user.Age())
-		if err != nil {
+		if err := user.AssignTenant(tenantID); err != nil {
+			return err
+		}
+		if err := user.AssignID(r.nextID); err != nil {
 			return err
 		}
-
-		r.users[r.nextID] = newUser
 		r.nextID++
-
-		// Assuming User is mutable and the original reference is important,
-		// we would need to update the original's ID. However, since our
-		// domain model currently doesn't allow this, it would be handled differently
-		// in a real application.
-
-		return nil
 	}
 
-	// For existing users, just update the stored reference
-	r.users[user.ID()] = user
+	r.users[user.ID()] = copyUser(user)
 	return nil
 }
 
 // Delete removes a user from the repository.
 func (r *InMemoryUserRepository) Delete(ctx context.Context, id int) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.users[id]; !exists {
+	if user, exists := r.users[id]; !exists || user.TenantID() != tenantID {
 		return errors.New("user not found")
 	}
 
@@ -105,16 +135,66 @@ func (r *InMemoryUserRepository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
-// ExistsByEmail checks if a user with the given email exists.
+// FindByEmail locates a user of the tenant by their email address.
+func (r *InMemoryUserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, user := range r.users {
+		if user.TenantID() == tenantID && user.Email().String() == email {
+			return copyUser(user), nil
+		}
+	}
+
+	return nil, errors.New("user not found")
+}
+
+// ExistsByEmail checks if a user of the tenant with the given email exists.
 func (r *InMemoryUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return false, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	for _, user := range r.users {
-		if user.Email() == email {
+		if user.TenantID() == tenantID && user.Email().String() == email {
 			return true, nil
 		}
 	}
 
 	return false, nil
 }
+
+// copyUser returns a copy of a user without its pending domain events, so
+// callers cannot modify stored state without saving and events are not stored
+func copyUser(user *model.User) *model.User {
+	copied := *user
+	copied.PullEvents()
+	return &copied
+}
+
+// tenantOf returns the tenant ctx is scoped to, failing if ctx is done or has no tenant
+func tenantOf(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return model.TenantFromContext(ctx)
+}
+
+// Close releases the repository's resources.
+// For the in-memory implementation this simply drops all stored users.
+func (r *InMemoryUserRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users = make(map[int]*model.User)
+	return nil
+}
@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// counter tracks the hits of a single key within a window
+type counter struct {
+	count   int
+	resetAt time.Time
+}
+
+// MemoryStore is a Store that keeps counters in process memory.
+// Counters are not shared between instances, so it suits single-instance
+// deployments, development and tests.
+type MemoryStore struct {
+	counters map[string]*counter
+	mu       sync.Mutex
+}
+
+// NewMemoryStore creates a new, empty in-memory rate limit store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		counters: make(map[string]*counter),
+	}
+}
+
+// Increment records a hit for key and returns the current count and reset time.
+func (s *MemoryStore) Increment(ctx context.Context, key string, window time.Duration) (int, time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, time.Time{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	c, exists := s.counters[key]
+	if !exists || !now.Before(c.resetAt) {
+		c = &counter{resetAt: now.Add(window)}
+		s.counters[key] = c
+	}
+	c.count++
+
+	return c.count, c.resetAt, nil
+}
+
+// RunCleanup periodically removes expired counters until ctx is canceled.
+// It is meant to be run as a background worker.
+func (s *MemoryStore) RunCleanup(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.removeExpired()
+		}
+	}
+}
+
+// removeExpired deletes all counters whose window has ended
+func (s *MemoryStore) removeExpired() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for key, c := range s.counters {
+		if !now.Before(c.resetAt) {
+			delete(s.counters, key)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Store keeps rate limit counters.
+// Implementations backed by a shared system (e.g. Redis) allow several API
+// instances to enforce the same limits. Increment must be atomic per key.
+type Store interface {
+	// Increment records a hit for key in the current fixed window of the given
+	// length and returns the number of hits so far and when the window resets.
+	Increment(ctx context.Context, key string, window time.Duration) (count int, resetAt time.Time, err error)
+}
@@ -0,0 +1,6 @@
+package secret
+
+import "errors"
+
+// ErrSecretNotFound is returned when a provider does not know the requested secret
+var ErrSecretNotFound = errors.New("secret not found")
@@ -0,0 +1,94 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cachedSecret is a secret value along with the modification time of its file
+type cachedSecret struct {
+	value   string
+	modTime time.Time
+}
+
+// FileProvider is a SecretProvider that reads each secret from its own file,
+// as mounted by Docker or Kubernetes secrets. A file is read again whenever its
+// modification time changes, so rotated secrets are picked up without a restart.
+type FileProvider struct {
+	paths    map[string]string // File path per secret name
+	validate func(name, value string) error
+	cache    map[string]cachedSecret
+	mu       sync.Mutex
+}
+
+// NewFileProvider creates a provider reading secrets from the given files, keyed by secret name.
+// Every value read is passed to validate, if not nil, and rejected when it returns an error,
+// so a rotated secret meets the same rules as the one the server started with.
+func NewFileProvider(paths map[string]string, validate func(name, value string) error) *FileProvider {
+	p := &FileProvider{
+		paths:    make(map[string]string, len(paths)),
+		validate: validate,
+		cache:    make(map[string]cachedSecret),
+	}
+	for name, path := range paths {
+		p.paths[name] = path
+	}
+	return p
+}
+
+// GetSecret returns the current contents of the named secret's file.
+// Trailing newlines are removed, as most tools add one when writing the file.
+func (p *FileProvider) GetSecret(ctx context.Context, name string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	path, ok := p.paths[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrSecretNotFound, name)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s: %w", name, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cached, ok := p.cache[name]; ok && cached.modTime.Equal(info.ModTime()) {
+		return cached.value, nil
+	}
+
+	value, err := ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s: %w", name, err)
+	}
+	if p.validate != nil {
+		if err := p.validate(name, value); err != nil {
+			return "", fmt.Errorf("invalid secret %s: %w", name, err)
+		}
+	}
+
+	p.cache[name] = cachedSecret{value: value, modTime: info.ModTime()}
+	return value, nil
+}
+
+// ReadFile returns the contents of a secret file without trailing newlines.
+// Empty files are rejected so a missing mount is not mistaken for an empty secret.
+func ReadFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	value := strings.TrimRight(string(data), "\r\n")
+	if value == "" {
+		return "", fmt.Errorf("secret file %s is empty", path)
+	}
+	return value, nil
+}
@@ -0,0 +1,68 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt_secret")
+	write := func(value string, modTime time.Time) {
+		if err := os.WriteFile(path, []byte(value), 0o600); err != nil {
+			t.Fatalf("WriteFile() unexpected error = %v", err)
+		}
+		// Set the modification time explicitly, as writes within the same tick may share one
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatalf("Chtimes() unexpected error = %v", err)
+		}
+	}
+
+	provider := NewFileProvider(map[string]string{"jwt": path}, func(_, value string) error {
+		if value == "weak" {
+			return errors.New("too short")
+		}
+		return nil
+	})
+	ctx := context.Background()
+	start := time.Now()
+
+	t.Run("Reads Value Without Trailing Newline", func(t *testing.T) {
+		write("first-secret\n", start)
+		got, err := provider.GetSecret(ctx, "jwt")
+		if err != nil || got != "first-secret" {
+			t.Errorf("GetSecret() = %q, %v, want %q", got, err, "first-secret")
+		}
+	})
+
+	t.Run("Picks Up Rotated Value", func(t *testing.T) {
+		write("second-secret\n", start.Add(time.Second))
+		got, err := provider.GetSecret(ctx, "jwt")
+		if err != nil || got != "second-secret" {
+			t.Errorf("GetSecret() = %q, %v, want %q", got, err, "second-secret")
+		}
+	})
+
+	t.Run("Rejects Invalid Value", func(t *testing.T) {
+		write("weak\n", start.Add(2*time.Second))
+		if _, err := provider.GetSecret(ctx, "jwt"); err == nil {
+			t.Errorf("GetSecret() error = nil, want error for invalid value")
+		}
+	})
+
+	t.Run("Rejects Empty File", func(t *testing.T) {
+		write("\n", start.Add(3*time.Second))
+		if _, err := provider.GetSecret(ctx, "jwt"); err == nil {
+			t.Errorf("GetSecret() error = nil, want error for empty file")
+		}
+	})
+
+	t.Run("Unknown Secret", func(t *testing.T) {
+		if _, err := provider.GetSecret(ctx, "db_password"); !errors.Is(err, ErrSecretNotFound) {
+			t.Errorf("GetSecret() error = %v, want %v", err, ErrSecretNotFound)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MemoryProvider is a SecretProvider that keeps secrets in process memory.
+// It suits tests and deployments where secrets are passed in the configuration.
+type MemoryProvider struct {
+	secrets map[string]string
+	mu      sync.RWMutex
+}
+
+// NewMemoryProvider creates a provider holding a copy of the given secrets.
+func NewMemoryProvider(secrets map[string]string) *MemoryProvider {
+	p := &MemoryProvider{
+		secrets: make(map[string]string, len(secrets)),
+	}
+	for name, value := range secrets {
+		p.secrets[name] = value
+	}
+	return p
+}
+
+// GetSecret returns the value of the named secret.
+func (p *MemoryProvider) GetSecret(ctx context.Context, name string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	value, ok := p.secrets[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrSecretNotFound, name)
+	}
+	return value, nil
+}
+
+// Set adds or replaces a secret, e.g. to simulate a rotation in tests.
+func (p *MemoryProvider) Set(name, value string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.secrets[name] = value
+}
@@ -0,0 +1,101 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parameters understood by every common authenticator app
+const (
+	secretSize = 20 // Secret length in bytes, the HMAC-SHA1 block recommended by RFC 4226
+	digits     = 6
+	period     = 30 * time.Second
+)
+
+// encoding is the base32 encoding of secrets, without padding as authenticator apps expect
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Generator is a TOTP implementation using HMAC-SHA1, 6 digits and 30 second steps,
+// the defaults of authenticator apps.
+type Generator struct {
+	skew int64 // Number of steps before and after the current one that are accepted
+}
+
+// NewGenerator creates a generator accepting codes up to skew steps away
+// from the current one, to allow for clock drift.
+func NewGenerator(skew int) *Generator {
+	return &Generator{skew: int64(skew)}
+}
+
+// NewSecret returns a new random secret, encoded in base32.
+func (g *Generator) NewSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate TOTP secret: %w", err)
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// ProvisioningURI returns the otpauth:// URI of secret in the Key Uri Format
+// understood by authenticator apps.
+func (g *Generator) ProvisioningURI(secret, issuer, account string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(digits))
+	query.Set("period", strconv.Itoa(int(period.Seconds())))
+
+	link := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}
+	return link.String()
+}
+
+// Verify checks a code for secret at the given time and returns the time step it belongs to.
+func (g *Generator) Verify(secret, code string, at time.Time) (int64, bool) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != digits {
+		return 0, false
+	}
+
+	current := at.Unix() / int64(period.Seconds())
+	for counter := current - g.skew; counter <= current+g.skew; counter++ {
+		if subtle.ConstantTimeCompare([]byte(Code(key, counter, digits)), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+// Code computes the HOTP value (RFC 4226) of key for a counter, with the given number of digits.
+func Code(key []byte, counter int64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
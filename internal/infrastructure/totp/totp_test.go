@@ -0,0 +1,74 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCode(t *testing.T) {
+	// SHA-1 test vectors from RFC 6238, Appendix B
+	key := []byte("12345678901234567890")
+	tests := map[int64]string{
+		59:          "94287082",
+		1111111109:  "07081804",
+		1111111111:  "14050471",
+		1234567890:  "89005924",
+		2000000000:  "69279037",
+		20000000000: "65353130",
+	}
+
+	for unix, want := range tests {
+		if got := Code(key, unix/30, 8); got != want {
+			t.Errorf("Code(%d) = %s, want %s", unix, got, want)
+		}
+	}
+}
+
+func TestGeneratorVerify(t *testing.T) {
+	g := NewGenerator(1)
+	secret, err := g.NewSecret()
+	if err != nil {
+		t.Fatalf("NewSecret() unexpected error = %v", err)
+	}
+	key, _ := encoding.DecodeString(secret)
+
+	now := time.Unix(1_700_000_000, 0)
+	step := now.Unix() / 30
+
+	tests := []struct {
+		name   string
+		code   string
+		want   int64
+		wantOK bool
+	}{
+		{"Current Step", Code(key, step, digits), step, true},
+		{"Previous Step", Code(key, step-1, digits), step - 1, true},
+		{"Next Step", Code(key, step+1, digits), step + 1, true},
+		{"Outside Skew", Code(key, step-2, digits), 0, false},
+		{"Wrong Length", "12345", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := g.Verify(secret, tt.code, now)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Verify() = (%d, %v), want (%d, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGeneratorProvisioningURI(t *testing.T) {
+	uri, err := url.Parse(NewGenerator(1).ProvisioningURI("JBSWY3DPEHPK3PXP", "Example API", "jane@example.com"))
+	if err != nil {
+		t.Fatalf("ProvisioningURI() is not a valid URL: %v", err)
+	}
+
+	if uri.Scheme != "otpauth" || uri.Host != "totp" || uri.Path != "/Example API:jane@example.com" {
+		t.Errorf("ProvisioningURI() = %s, want otpauth://totp/Example API:jane@example.com", uri)
+	}
+	if query := uri.Query(); query.Get("secret") != "JBSWY3DPEHPK3PXP" || query.Get("issuer") != "Example API" {
+		t.Errorf("ProvisioningURI() query = %v, want secret and issuer", query)
+	}
+}
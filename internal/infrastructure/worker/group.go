@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"sync"
+
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+)
+
+// Group runs background workers that share a single lifetime.
+// Every worker receives a context that is canceled when the group is stopped,
+// so long-running loops can exit cleanly during application shutdown.
+type Group struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewGroup creates a new, empty worker group.
+func NewGroup() *Group {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Group{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Go starts fn in a new goroutine as part of the group.
+// The worker must return once its context is canceled.
+func (g *Group) Go(name string, fn func(ctx context.Context)) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		logger.Debug(constants.WorkerStarted, name)
+		fn(g.ctx)
+		logger.Debug(constants.WorkerStopped, name)
+	}()
+}
+
+// Stop signals all workers to finish and waits until they have returned
+// or the given context expires, whichever comes first.
+func (g *Group) Stop(ctx context.Context) error {
+	g.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// APIKeyController handles the administration of API keys.
+type APIKeyController struct {
+	apiKeyService *service.APIKeyService
+}
+
+// NewAPIKeyController creates a new instance of the API key controller.
+func NewAPIKeyController(apiKeyService *service.APIKeyService) *APIKeyController {
+	return &APIKeyController{
+		apiKeyService: apiKeyService,
+	}
+}
+
+// ListAPIKeys handles the request to list API keys.
+// @Summary      List API keys
+// @Description  Lists all API keys, including expired and revoked ones, without their secrets (admin only)
+// @Tags         api-keys
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  api.ResponseModel{data=[]dto.APIKeyResponse}
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /admin/api-keys [get]
+func (c *APIKeyController) ListAPIKeys(ctx fiber.Ctx) error {
+	keys, err := c.apiKeyService.List(ctx.Context())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetAPIKeys)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.APIKeysFetched),
+		keys,
+	))
+}
+
+// CreateAPIKey handles the request to create an API key.
+// @Summary      Create API key
+// @Description  Creates an API key with the given scopes and optional expiry (admin only). The key is returned only once; send it in the X-API-Key header
+// @Tags         api-keys
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        key  body      dto.APIKeyRequest  true  "API key"
+// @Success      201  {object}  api.ResponseModel{data=dto.APIKeyCreatedResponse}
+// @Failure      400  {object}  api.Problem  "Invalid request or scope"
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope, not an administrator, or a scope the token used does not hold"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /admin/api-keys [post]
+func (c *APIKeyController) CreateAPIKey(ctx fiber.Ctx) error {
+	var request dto.APIKeyRequest
+
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotCreateAPIKey)
+	}
+
+	actor := common.Principal(ctx).Username
+	key, err := c.apiKeyService.Create(ctx.Context(), request, actor, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotCreateAPIKey)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewSuccessResponse(
+		Localize(ctx, constants.APIKeyCreated),
+		key,
+	))
+}
+
+// RevokeAPIKey handles the request to revoke an API key.
+// @Summary      Revoke API key
+// @Description  Permanently disables an API key (admin only). Revoked keys remain listed
+// @Tags         api-keys
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "API key ID"
+// @Success      200  {object}  api.ResponseModel{data=dto.APIKeyResponse}
+// @Failure      400  {object}  api.Problem  "Invalid ID format"
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      404  {object}  api.Problem  "API key not found"
+// @Failure      409  {object}  api.Problem  "API key already revoked"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /admin/api-keys/{id} [delete]
+func (c *APIKeyController) RevokeAPIKey(ctx fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	actor := common.Principal(ctx).Username
+	key, err := c.apiKeyService.Revoke(ctx.Context(), id, actor, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotRevokeAPIKey)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.APIKeyRevoked),
+		key,
+	))
+}
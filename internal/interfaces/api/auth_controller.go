@@ -1,8 +1,12 @@
 package api
 
 import (
+	"errors"
 	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"strconv"
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -11,11 +15,34 @@ import (
 type LoginRequest struct {
 	Username string `json:"username" validate:"required"`
 	Password string `json:"password" validate:"required"`
+	Scope    string `json:"scope" example:"users:read"` // Optional space-separated scopes to limit the token to; all scopes if omitted
 }
 
 // LoginResponse defines the response structure for successful login.
+// Users with MFA enabled receive an MFA token instead of an access token,
+// to be completed with a code at /login/mfa.
 type LoginResponse struct {
-	Token string `json:"token"`
+	Token        string `json:"token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"` // Exchanged at /login/refresh for a new token; replaced on every use
+	MFARequired  bool   `json:"mfa_required,omitempty"`
+	MFAToken     string `json:"mfa_token,omitempty"`
+}
+
+// MFALoginRequest completes a login with the second factor.
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`             // Token returned by /login
+	Code     string `json:"code" validate:"required" example:"123456"` // Authenticator code or recovery code
+}
+
+// RefreshRequest exchanges a refresh token for a new access token.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"` // Refresh token returned by the last login or refresh
+}
+
+// UnlockRequest identifies the account and/or client IP address to unlock.
+type UnlockRequest struct {
+	Username string `json:"username"`
+	IP       string `json:"ip" validate:"omitempty,ip"`
 }
 
 // AuthController handles authentication-related requests.
@@ -32,41 +59,158 @@ func NewAuthController(authService *service.AuthService) *AuthController {
 
 // Login authenticates a user and issues a JWT token.
 // @Summary      User login
-// @Description  Authenticates with username and password to receive a JWT token
+// @Description  Authenticates with username and password to start a session and receive a JWT token and a refresh token, granted the requested scopes or all scopes. Users with multi-factor authentication enabled receive an MFA token to complete at /login/mfa instead
 // @Tags         auth
 // @Accept       json
 // @Produce      json
 // @Param        login  body      api.LoginRequest  true  "User credentials"
 // @Success      200    {object}  api.ResponseModel{data=api.LoginResponse}
-// @Failure      400    {object}  api.ResponseModel
-// @Failure      401    {object}  api.ResponseModel
-// @Failure      500    {object}  api.ResponseModel
+// @Failure      400    {object}  api.Problem  "Invalid request or scope"
+// @Failure      401    {object}  api.Problem
+// @Failure      429    {object}  api.Problem  "Too many failed attempts or account locked"
+// @Failure      500    {object}  api.Problem
 // @Router       /login [post]
 func (c *AuthController) Login(ctx fiber.Ctx) error {
 	var req LoginRequest
 
 	// Parse and validate request body
 	if err := ValidateRequest(ctx, &req); err != nil {
-		return ctx.Status(err.Code).JSON(NewErrorResponse(
-			constants.InvalidRequestFormat,
-			err.Message,
-		))
+		return HandleDomainError(ctx, err, constants.InvalidRequestFormat)
 	}
 
 	// Authenticate and get token
-	token, err := c.authService.Login(req.Username, req.Password)
+	result, err := c.authService.Login(ctx.Context(), req.Username, req.Password, req.Scope, ctx.IP(), common.UserAgent(ctx))
 	if err != nil {
-		return ctx.Status(fiber.StatusUnauthorized).JSON(NewErrorResponse(
-			constants.AuthenticationFailed,
-			err.Error(),
+		return handleLoginError(ctx, err)
+	}
+
+	// Ask for the second factor
+	if result.MFAToken != "" {
+		return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+			Localize(ctx, constants.MFACodeRequired),
+			LoginResponse{
+				MFARequired: true,
+				MFAToken:    result.MFAToken,
+			},
 		))
 	}
 
 	// Return successful response with token
 	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
-		constants.LoginSuccess,
+		Localize(ctx, constants.LoginSuccess),
+		LoginResponse{
+			Token:        result.Token,
+			RefreshToken: result.RefreshToken,
+		},
+	))
+}
+
+// LoginMFA completes a login with multi-factor authentication.
+// @Summary      Complete login with MFA
+// @Description  Exchanges the MFA token returned by /login and a code from the authenticator app, or an unused recovery code, for a JWT token and a refresh token. Wrong codes count towards the account lockout
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        login  body      api.MFALoginRequest  true  "MFA token and code"
+// @Success      200    {object}  api.ResponseModel{data=api.LoginResponse}
+// @Failure      400    {object}  api.Problem
+// @Failure      401    {object}  api.Problem  "Invalid code or expired MFA token"
+// @Failure      403    {object}  api.Problem  "Account disabled"
+// @Failure      429    {object}  api.Problem  "Too many failed attempts or account locked"
+// @Failure      500    {object}  api.Problem
+// @Router       /login/mfa [post]
+func (c *AuthController) LoginMFA(ctx fiber.Ctx) error {
+	var req MFALoginRequest
+
+	if err := ValidateRequest(ctx, &req); err != nil {
+		return HandleDomainError(ctx, err, constants.InvalidRequestFormat)
+	}
+
+	result, err := c.authService.CompleteMFA(ctx.Context(), req.MFAToken, req.Code, ctx.IP(), common.UserAgent(ctx))
+	if err != nil {
+		return handleLoginError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.LoginSuccess),
+		LoginResponse{
+			Token:        result.Token,
+			RefreshToken: result.RefreshToken,
+		},
+	))
+}
+
+// Refresh issues a new access token for a session.
+// @Summary      Refresh access token
+// @Description  Exchanges the refresh token of a session for a new JWT token and a new refresh token. Each refresh token can be used once; sessions revoked, expired or started before the user's last password change are rejected
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        refresh  body      api.RefreshRequest  true  "Refresh token"
+// @Success      200      {object}  api.ResponseModel{data=api.LoginResponse}
+// @Failure      400      {object}  api.Problem
+// @Failure      401      {object}  api.Problem  "Invalid, used or revoked refresh token"
+// @Failure      403      {object}  api.Problem  "Account disabled"
+// @Failure      500      {object}  api.Problem
+// @Router       /login/refresh [post]
+func (c *AuthController) Refresh(ctx fiber.Ctx) error {
+	var req RefreshRequest
+
+	if err := ValidateRequest(ctx, &req); err != nil {
+		return HandleDomainError(ctx, err, constants.InvalidRequestFormat)
+	}
+
+	result, err := c.authService.Refresh(ctx.Context(), req.RefreshToken, ctx.IP(), common.UserAgent(ctx))
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotRefreshToken)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.LoginSuccess),
 		LoginResponse{
-			Token: token,
+			Token:        result.Token,
+			RefreshToken: result.RefreshToken,
 		},
 	))
 }
+
+// handleLoginError reports a failed login step, telling throttled clients when to retry
+func handleLoginError(ctx fiber.Ctx, err error) error {
+	var tooManyErr *appErrors.ErrTooManyAttempts
+	if errors.As(err, &tooManyErr) {
+		ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(tooManyErr.RetryAfter.Seconds())))
+	}
+	return HandleDomainError(ctx, err, constants.AuthenticationFailed)
+}
+
+// Unlock clears a login lockout for an account and/or client IP address.
+// @Summary      Clear login lockout
+// @Description  Clears failed login attempts and lockouts for a username and/or client IP address (admin only)
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        unlock  body      api.UnlockRequest  true  "Account and/or IP address to unlock"
+// @Success      200     {object}  api.ResponseModel
+// @Failure      400     {object}  api.Problem  "Invalid request"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /admin/unlock [post]
+func (c *AuthController) Unlock(ctx fiber.Ctx) error {
+	var req UnlockRequest
+
+	if err := ValidateRequest(ctx, &req); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotUnlock)
+	}
+
+	admin := common.Principal(ctx).Username
+	if err := c.authService.Unlock(ctx.Context(), admin, req.Username, req.IP, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotUnlock)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.UnlockSuccess),
+		nil,
+	))
+}
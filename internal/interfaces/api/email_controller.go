@@ -0,0 +1,91 @@
+package api
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// VerifyEmailRequest carries the token from an email verification link.
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// ResendVerificationRequest identifies the address to send a new verification link to.
+type ResendVerificationRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// EmailController handles email verification requests.
+type EmailController struct {
+	verificationService *service.EmailVerificationService
+}
+
+// NewEmailController creates a new instance of the email controller.
+func NewEmailController(verificationService *service.EmailVerificationService) *EmailController {
+	return &EmailController{
+		verificationService: verificationService,
+	}
+}
+
+// Verify confirms an email address with the token from a verification link.
+// @Summary      Verify email address
+// @Description  Confirms the email address with the single-use token sent in the verification link
+// @Tags         email
+// @Accept       json
+// @Produce      json
+// @Param        request  body      api.VerifyEmailRequest  true  "Verification token"
+// @Success      200      {object}  api.ResponseModel{data=dto.UserResponse}
+// @Failure      400      {object}  api.Problem  "Invalid, expired or used token"
+// @Failure      429      {object}  api.Problem  "Rate limit exceeded"
+// @Failure      500      {object}  api.Problem  "Internal server error"
+// @Router       /email/verify [post]
+func (c *EmailController) Verify(ctx fiber.Ctx) error {
+	var req VerifyEmailRequest
+
+	if err := ValidateRequest(ctx, &req); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotVerifyEmail)
+	}
+
+	var user *dto.UserResponse
+	user, err := c.verificationService.Verify(ctx.Context(), req.Token)
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotVerifyEmail)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.EmailVerified),
+		user,
+	))
+}
+
+// Resend sends a new verification link.
+// @Summary      Resend verification email
+// @Description  Sends a new verification link if the address belongs to an unverified user. Always responds with 202 so it can't be used to discover accounts; links are sent at most once per resend interval
+// @Tags         email
+// @Accept       json
+// @Produce      json
+// @Param        request  body      api.ResendVerificationRequest  true  "Email address"
+// @Success      202      {object}  api.ResponseModel
+// @Failure      400      {object}  api.Problem  "Invalid request"
+// @Failure      429      {object}  api.Problem  "Rate limit exceeded"
+// @Failure      500      {object}  api.Problem  "Internal server error"
+// @Router       /email/verify/resend [post]
+func (c *EmailController) Resend(ctx fiber.Ctx) error {
+	var req ResendVerificationRequest
+
+	if err := ValidateRequest(ctx, &req); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotResendVerification)
+	}
+
+	if err := c.verificationService.Resend(ctx.Context(), req.Email); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotResendVerification)
+	}
+
+	return ctx.Status(fiber.StatusAccepted).JSON(NewSuccessResponse(
+		Localize(ctx, constants.VerificationSent),
+		nil,
+	))
+}
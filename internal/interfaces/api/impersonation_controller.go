@@ -0,0 +1,66 @@
+package api
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// ImpersonationResponse holds a token letting an administrator act as a user.
+type ImpersonationResponse struct {
+	UserID    int       `json:"user_id"`                                   // Impersonated user
+	Token     string    `json:"token"`                                     // Access token, sent as a Bearer token
+	ExpiresAt time.Time `json:"expires_at" example:"2030-01-01T00:15:00Z"` // Expiry time (UTC)
+}
+
+// ImpersonationController handles administrators acting as a user.
+type ImpersonationController struct {
+	impersonationService *service.ImpersonationService
+}
+
+// NewImpersonationController creates a new instance of the impersonation controller.
+func NewImpersonationController(impersonationService *service.ImpersonationService) *ImpersonationController {
+	return &ImpersonationController{
+		impersonationService: impersonationService,
+	}
+}
+
+// Impersonate handles the request to act as a user.
+// @Summary      Impersonate user
+// @Description  Issues a short-lived token to act as the user, for reproducing issues (admin only). The token names the administrator in an "act" claim (RFC 8693), cannot change the user's password or MFA settings, and every request made with it is recorded in the audit log
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "User ID"
+// @Success      200  {object}  api.ResponseModel{data=api.ImpersonationResponse}
+// @Failure      400  {object}  api.Problem  "Invalid ID format"
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope, not an administrator, user cannot be impersonated or account disabled"
+// @Failure      404  {object}  api.Problem  "User not found"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /admin/impersonate/{id} [post]
+func (c *ImpersonationController) Impersonate(ctx fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	impersonation, err := c.impersonationService.Impersonate(ctx.Context(), common.Principal(ctx), id, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotImpersonate)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.ImpersonationStarted),
+		ImpersonationResponse{
+			UserID:    impersonation.UserID,
+			Token:     impersonation.Token,
+			ExpiresAt: impersonation.ExpiresAt.UTC(),
+		},
+	))
+}
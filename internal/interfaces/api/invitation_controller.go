@@ -0,0 +1,196 @@
+package api
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// InvitationController handles invitations to teams.
+type InvitationController struct {
+	invitationService *service.InvitationService
+}
+
+// NewInvitationController creates a new instance of the invitation controller.
+func NewInvitationController(invitationService *service.InvitationService) *InvitationController {
+	return &InvitationController{
+		invitationService: invitationService,
+	}
+}
+
+// ListInvitations handles the request to list the pending invitations to a team.
+// @Summary      List team invitations
+// @Description  Lists the invitations to a team that were not answered yet, including expired ones (team owners and admins)
+// @Tags         invitations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId   path      int  true  "Organization ID"
+// @Param        teamId  path      int  true  "Team ID"
+// @Success      200     {object}  api.ResponseModel{data=[]dto.InvitationResponse}
+// @Failure      400     {object}  api.Problem  "Invalid ID format"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient role"
+// @Failure      404     {object}  api.Problem  "Organization or team not found"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams/{teamId}/invitations [get]
+func (c *InvitationController) ListInvitations(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "teamId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var invitations []dto.InvitationResponse
+	invitations, err = c.invitationService.List(ctx.Context(), currentUserID(ctx), ids[0], ids[1])
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetInvitations)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.InvitationsFetched),
+		invitations,
+	))
+}
+
+// CreateInvitation handles the request to invite someone to a team.
+// @Summary      Invite to team
+// @Description  Emails an invitation to join a team in the given role, "member" by default. Team owners and admins may invite, though only owners may invite owners. Replaces any pending invitation of the address to the team
+// @Tags         invitations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId       path      int                    true  "Organization ID"
+// @Param        teamId      path      int                    true  "Team ID"
+// @Param        invitation  body      dto.InvitationRequest  true  "Invitation"
+// @Success      201         {object}  api.ResponseModel{data=dto.InvitationResponse}
+// @Failure      400         {object}  api.Problem  "Invalid request"
+// @Failure      401         {object}  api.Problem  "Unauthorized"
+// @Failure      403         {object}  api.Problem  "Insufficient role"
+// @Failure      404         {object}  api.Problem  "Organization or team not found"
+// @Failure      409         {object}  api.Problem  "Already a member of the team"
+// @Failure      500         {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams/{teamId}/invitations [post]
+func (c *InvitationController) CreateInvitation(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "teamId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var request dto.InvitationRequest
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotSendInvitation)
+	}
+
+	actor := common.Principal(ctx).Username
+	invitation, err := c.invitationService.Create(ctx.Context(), currentUserID(ctx), ids[0], ids[1], request, actor, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotSendInvitation)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewSuccessResponse(
+		Localize(ctx, constants.InvitationSent),
+		invitation,
+	))
+}
+
+// CancelInvitation handles the request to cancel an invitation to a team.
+// @Summary      Cancel team invitation
+// @Description  Cancels a pending invitation to a team, so its link stops working (team owners and admins)
+// @Tags         invitations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId         path      int  true  "Organization ID"
+// @Param        teamId        path      int  true  "Team ID"
+// @Param        invitationId  path      int  true  "Invitation ID"
+// @Success      200           {object}  api.ResponseModel
+// @Failure      400           {object}  api.Problem  "Invalid ID format"
+// @Failure      401           {object}  api.Problem  "Unauthorized"
+// @Failure      403           {object}  api.Problem  "Insufficient role"
+// @Failure      404           {object}  api.Problem  "Organization, team or invitation not found"
+// @Failure      500           {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams/{teamId}/invitations/{invitationId} [delete]
+func (c *InvitationController) CancelInvitation(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "teamId", "invitationId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	actor := common.Principal(ctx).Username
+	if err := c.invitationService.Cancel(ctx.Context(), currentUserID(ctx), ids[0], ids[1], ids[2], actor, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotCancelInvitation)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.InvitationCanceled),
+		nil,
+	))
+}
+
+// AcceptInvitation handles the request to accept an invitation.
+// @Summary      Accept invitation
+// @Description  Joins the team of an invitation with the token from the invitation email, and its organization if needed. The signed-in user's verified email address must be the one the invitation was sent to
+// @Tags         invitations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        invitation  body      dto.InvitationTokenRequest  true  "Invitation token"
+// @Success      200         {object}  api.ResponseModel{data=dto.TeamResponse}
+// @Failure      400         {object}  api.Problem  "Invalid request or invitation"
+// @Failure      401         {object}  api.Problem  "Unauthorized"
+// @Failure      403         {object}  api.Problem  "Not a signed-in user"
+// @Failure      409         {object}  api.Problem  "Already a member of the team"
+// @Failure      500         {object}  api.Problem  "Internal server error"
+// @Router       /orgs/invitations/accept [post]
+func (c *InvitationController) AcceptInvitation(ctx fiber.Ctx) error {
+	var request dto.InvitationTokenRequest
+
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotAnswerInvitation)
+	}
+
+	actor := common.Principal(ctx).Username
+	team, err := c.invitationService.Accept(ctx.Context(), currentUserID(ctx), request.Token, actor, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotAnswerInvitation)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.InvitationAccepted),
+		team,
+	))
+}
+
+// DeclineInvitation handles the request to decline an invitation.
+// @Summary      Decline invitation
+// @Description  Turns down an invitation with the token from the invitation email. The signed-in user's verified email address must be the one the invitation was sent to
+// @Tags         invitations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        invitation  body      dto.InvitationTokenRequest  true  "Invitation token"
+// @Success      200         {object}  api.ResponseModel
+// @Failure      400         {object}  api.Problem  "Invalid request or invitation"
+// @Failure      401         {object}  api.Problem  "Unauthorized"
+// @Failure      403         {object}  api.Problem  "Not a signed-in user"
+// @Failure      500         {object}  api.Problem  "Internal server error"
+// @Router       /orgs/invitations/decline [post]
+func (c *InvitationController) DeclineInvitation(ctx fiber.Ctx) error {
+	var request dto.InvitationTokenRequest
+
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotAnswerInvitation)
+	}
+
+	actor := common.Principal(ctx).Username
+	if err := c.invitationService.Decline(ctx.Context(), currentUserID(ctx), request.Token, actor, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotAnswerInvitation)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.InvitationDeclined),
+		nil,
+	))
+}
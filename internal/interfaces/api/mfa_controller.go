@@ -0,0 +1,126 @@
+package api
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// MFACodeRequest carries a code from the user's authenticator app.
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required" example:"123456"` // Authenticator code, or a recovery code where accepted
+}
+
+// MFAController handles multi-factor authentication settings of the signed-in user.
+type MFAController struct {
+	mfaService  *service.MFAService
+	authService *service.AuthService // Counts wrong codes towards the lockout of the second factor
+}
+
+// NewMFAController creates a new instance of the MFA controller.
+func NewMFAController(mfaService *service.MFAService, authService *service.AuthService) *MFAController {
+	return &MFAController{
+		mfaService:  mfaService,
+		authService: authService,
+	}
+}
+
+// Enroll starts enrolling an authenticator app.
+// @Summary      Start MFA enrollment
+// @Description  Creates a TOTP secret for the signed-in user. Show the provisioning URI as a QR code for the authenticator app, then confirm with a code. MFA is not enabled until confirmed
+// @Tags         mfa
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  api.ResponseModel{data=dto.MFAEnrollmentResponse}
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Not allowed while impersonating"
+// @Failure      409  {object}  api.Problem  "MFA already enabled"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /mfa/enroll [post]
+func (c *MFAController) Enroll(ctx fiber.Ctx) error {
+	var enrollment *dto.MFAEnrollmentResponse
+	enrollment, err := c.mfaService.Enroll(ctx.Context(), currentUserID(ctx))
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotEnrollMFA)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.MFAEnrollmentStarted),
+		enrollment,
+	))
+}
+
+// Confirm enables MFA with a code from the enrolled authenticator app.
+// @Summary      Confirm MFA enrollment
+// @Description  Enables MFA with a code from the authenticator app and returns single-use recovery codes, which are shown only once
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      api.MFACodeRequest  true  "Authenticator code"
+// @Success      200      {object}  api.ResponseModel{data=dto.MFARecoveryCodesResponse}
+// @Failure      400      {object}  api.Problem  "Invalid request"
+// @Failure      401      {object}  api.Problem  "Unauthorized or invalid code"
+// @Failure      403      {object}  api.Problem  "Not allowed while impersonating"
+// @Failure      409      {object}  api.Problem  "MFA already enabled or enrollment not started"
+// @Failure      429      {object}  api.Problem  "Too many wrong codes"
+// @Failure      500      {object}  api.Problem  "Internal server error"
+// @Router       /mfa/confirm [post]
+func (c *MFAController) Confirm(ctx fiber.Ctx) error {
+	var req MFACodeRequest
+
+	if err := ValidateRequest(ctx, &req); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotConfirmMFA)
+	}
+
+	codes, err := c.authService.ConfirmMFA(ctx.Context(), currentUserID(ctx), common.Principal(ctx).Username, req.Code, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotConfirmMFA)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.MFAEnabled),
+		codes,
+	))
+}
+
+// Disable turns MFA off.
+// @Summary      Disable MFA
+// @Description  Disables MFA after checking a current authenticator code or an unused recovery code
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      api.MFACodeRequest  true  "Authenticator or recovery code"
+// @Success      200      {object}  api.ResponseModel
+// @Failure      400      {object}  api.Problem  "Invalid request"
+// @Failure      401      {object}  api.Problem  "Unauthorized or invalid code"
+// @Failure      403      {object}  api.Problem  "Not allowed while impersonating"
+// @Failure      409      {object}  api.Problem  "MFA not enabled"
+// @Failure      429      {object}  api.Problem  "Too many wrong codes"
+// @Failure      500      {object}  api.Problem  "Internal server error"
+// @Router       /mfa/disable [post]
+func (c *MFAController) Disable(ctx fiber.Ctx) error {
+	var req MFACodeRequest
+
+	if err := ValidateRequest(ctx, &req); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotDisableMFA)
+	}
+
+	if err := c.authService.DisableMFA(ctx.Context(), currentUserID(ctx), common.Principal(ctx).Username, req.Code, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotDisableMFA)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.MFADisabled),
+		nil,
+	))
+}
+
+// currentUserID returns the ID of the signed-in user, stored by JWTProtected
+func currentUserID(ctx fiber.Ctx) int {
+	return common.Principal(ctx).UserID
+}
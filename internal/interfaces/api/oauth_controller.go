@@ -0,0 +1,271 @@
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// OAuthController handles the OAuth2 endpoints and the administration of OAuth clients.
+// The OAuth2 endpoints take form parameters and answer in the formats of their
+// RFCs rather than with the API's usual envelope and problem details.
+type OAuthController struct {
+	oauthService *service.OAuthService
+}
+
+// NewOAuthController creates a new instance of the OAuth controller.
+func NewOAuthController(oauthService *service.OAuthService) *OAuthController {
+	return &OAuthController{
+		oauthService: oauthService,
+	}
+}
+
+// Token handles the OAuth2 token request.
+// @Summary      Issue OAuth2 tokens
+// @Description  Issues an access token to a registered client (RFC 6749), with the client_credentials or refresh_token grant, and a single-use refresh token to clients registered for refresh tokens. The client authenticates with HTTP Basic or the client_id and client_secret parameters
+// @Tags         oauth
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Security     ClientAuth
+// @Param        grant_type     formData  string  true   "client_credentials or refresh_token"
+// @Param        scope          formData  string  false  "Space-separated scopes, defaults to all scopes allowed"
+// @Param        refresh_token  formData  string  false  "Refresh token, for the refresh_token grant"
+// @Param        client_id      formData  string  false  "Client ID, unless sent with HTTP Basic"
+// @Param        client_secret  formData  string  false  "Client secret, unless sent with HTTP Basic"
+// @Success      200  {object}  dto.OAuthTokenResponse
+// @Failure      400  {object}  dto.OAuthErrorResponse  "invalid_request, invalid_grant, invalid_scope or unsupported_grant_type"
+// @Failure      401  {object}  dto.OAuthErrorResponse  "invalid_client"
+// @Failure      500  {object}  dto.OAuthErrorResponse  "server_error"
+// @Router       /oauth/token [post]
+func (c *OAuthController) Token(ctx fiber.Ctx) error {
+	client, err := c.authenticateClient(ctx)
+	if err != nil {
+		return sendOAuthError(ctx, err)
+	}
+
+	response, err := c.oauthService.Token(ctx.Context(), client, dto.OAuthTokenRequest{
+		GrantType:    ctx.FormValue("grant_type"),
+		Scope:        ctx.FormValue("scope"),
+		RefreshToken: ctx.FormValue("refresh_token"),
+	})
+	if err != nil {
+		return sendOAuthError(ctx, err)
+	}
+
+	return sendOAuthResponse(ctx, fiber.StatusOK, response)
+}
+
+// Introspect handles the OAuth2 token introspection request.
+// @Summary      Introspect a token
+// @Description  Reports whether an access or refresh token is active and describes it (RFC 7662). Access tokens of users are only described to clients allowed the tokens:introspect scope, and refresh tokens only to the client they were issued to
+// @Tags         oauth
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Security     ClientAuth
+// @Param        token            formData  string  true   "Token to describe"
+// @Param        token_type_hint  formData  string  false  "access_token or refresh_token"
+// @Success      200  {object}  dto.OAuthIntrospectionResponse
+// @Failure      400  {object}  dto.OAuthErrorResponse  "invalid_request"
+// @Failure      401  {object}  dto.OAuthErrorResponse  "invalid_client"
+// @Failure      500  {object}  dto.OAuthErrorResponse  "server_error"
+// @Router       /oauth/introspect [post]
+func (c *OAuthController) Introspect(ctx fiber.Ctx) error {
+	client, err := c.authenticateClient(ctx)
+	if err != nil {
+		return sendOAuthError(ctx, err)
+	}
+
+	token := ctx.FormValue("token")
+	if token == "" {
+		return sendOAuthError(ctx, &appErrors.ErrInvalidRequest{Field: "token", Message: "is required"})
+	}
+
+	response, err := c.oauthService.Introspect(ctx.Context(), client, token, ctx.FormValue("token_type_hint"))
+	if err != nil {
+		return sendOAuthError(ctx, err)
+	}
+
+	return sendOAuthResponse(ctx, fiber.StatusOK, response)
+}
+
+// Revoke handles the OAuth2 token revocation request.
+// @Summary      Revoke a token
+// @Description  Revokes an access or refresh token issued to the client (RFC 7009). Unknown tokens and tokens of other clients are ignored
+// @Tags         oauth
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Security     ClientAuth
+// @Param        token            formData  string  true   "Token to revoke"
+// @Param        token_type_hint  formData  string  false  "access_token or refresh_token"
+// @Success      200  "Token revoked or ignored"
+// @Failure      400  {object}  dto.OAuthErrorResponse  "invalid_request"
+// @Failure      401  {object}  dto.OAuthErrorResponse  "invalid_client"
+// @Failure      500  {object}  dto.OAuthErrorResponse  "server_error"
+// @Router       /oauth/revoke [post]
+func (c *OAuthController) Revoke(ctx fiber.Ctx) error {
+	client, err := c.authenticateClient(ctx)
+	if err != nil {
+		return sendOAuthError(ctx, err)
+	}
+
+	token := ctx.FormValue("token")
+	if token == "" {
+		return sendOAuthError(ctx, &appErrors.ErrInvalidRequest{Field: "token", Message: "is required"})
+	}
+
+	if err := c.oauthService.Revoke(ctx.Context(), client, token, ctx.FormValue("token_type_hint")); err != nil {
+		return sendOAuthError(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+// ListClients handles the request to list OAuth clients.
+// @Summary      List OAuth clients
+// @Description  Lists all registered OAuth clients without their secrets (admin only)
+// @Tags         oauth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  api.ResponseModel{data=[]dto.OAuthClientResponse}
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /admin/oauth-clients [get]
+func (c *OAuthController) ListClients(ctx fiber.Ctx) error {
+	clients, err := c.oauthService.ListClients(ctx.Context())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetOAuthClients)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.OAuthClientsFetched),
+		clients,
+	))
+}
+
+// CreateClient handles the request to register an OAuth client.
+// @Summary      Register OAuth client
+// @Description  Registers an OAuth client allowed the given scopes (admin only). The client secret is returned only once
+// @Tags         oauth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        client  body      dto.OAuthClientRequest  true  "OAuth client"
+// @Success      201     {object}  api.ResponseModel{data=dto.OAuthClientCreatedResponse}
+// @Failure      400     {object}  api.Problem  "Invalid request or scope"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient scope, not an administrator, or a scope the token used does not hold"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /admin/oauth-clients [post]
+func (c *OAuthController) CreateClient(ctx fiber.Ctx) error {
+	var request dto.OAuthClientRequest
+
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotCreateOAuthClient)
+	}
+
+	actor := common.Principal(ctx).Username
+	client, err := c.oauthService.CreateClient(ctx.Context(), request, actor, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotCreateOAuthClient)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewSuccessResponse(
+		Localize(ctx, constants.OAuthClientCreated),
+		client,
+	))
+}
+
+// DeleteClient handles the request to delete an OAuth client.
+// @Summary      Delete OAuth client
+// @Description  Deletes an OAuth client and its refresh tokens (admin only). Access tokens already issued remain valid until they expire
+// @Tags         oauth
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "OAuth client ID"
+// @Success      200  {object}  api.ResponseModel
+// @Failure      400  {object}  api.Problem  "Invalid ID format"
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      404  {object}  api.Problem  "OAuth client not found"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /admin/oauth-clients/{id} [delete]
+func (c *OAuthController) DeleteClient(ctx fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	actor := common.Principal(ctx).Username
+	if err := c.oauthService.DeleteClient(ctx.Context(), id, actor, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotDeleteOAuthClient)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.OAuthClientDeleted),
+		nil,
+	))
+}
+
+// authenticateClient authenticates the client making an OAuth2 request with
+// HTTP Basic (client_secret_basic) or the client_id and client_secret form
+// parameters (client_secret_post), as described in RFC 6749 section 2.3.1
+func (c *OAuthController) authenticateClient(ctx fiber.Ctx) (*model.OAuthClient, error) {
+	clientID, secret := ctx.FormValue("client_id"), ctx.FormValue("client_secret")
+
+	if scheme, credentials, ok := strings.Cut(ctx.Get(fiber.HeaderAuthorization), " "); ok && strings.EqualFold(scheme, "Basic") {
+		decoded, err := base64.StdEncoding.DecodeString(credentials)
+		if err != nil {
+			return nil, service.ErrInvalidClient
+		}
+		// Both parts are form-encoded before being joined
+		id, pass, _ := strings.Cut(string(decoded), ":")
+		if clientID, err = url.QueryUnescape(id); err != nil {
+			return nil, service.ErrInvalidClient
+		}
+		if secret, err = url.QueryUnescape(pass); err != nil {
+			return nil, service.ErrInvalidClient
+		}
+	}
+
+	return c.oauthService.AuthenticateClient(ctx.Context(), clientID, secret)
+}
+
+// sendOAuthResponse writes a response of the OAuth2 endpoints, which must not be cached
+func sendOAuthResponse(ctx fiber.Ctx, status int, body any) error {
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+	ctx.Set(fiber.HeaderPragma, "no-cache")
+	return ctx.Status(status).JSON(body)
+}
+
+// sendOAuthError writes an OAuth2 error response (RFC 6749 section 5.2).
+// The error code and status come from the pkg/errors registry; descriptions
+// are not translated, as they are meant for developers and limited to ASCII.
+func sendOAuthError(ctx fiber.Ctx, err error) error {
+	mapping, ok := appErrors.Lookup(err)
+	if !ok || mapping.Status >= fiber.StatusInternalServerError {
+		logger.Error(constants.OAuthRequestFailed, err)
+		return sendOAuthResponse(ctx, fiber.StatusInternalServerError, dto.OAuthErrorResponse{Error: "server_error"})
+	}
+
+	description := mapping.Detail
+	if description == "" {
+		description = err.Error()
+	}
+
+	if errors.Is(err, service.ErrInvalidClient) {
+		ctx.Set(fiber.HeaderWWWAuthenticate, `Basic realm="oauth"`)
+	}
+	return sendOAuthResponse(ctx, mapping.Status, dto.OAuthErrorResponse{Error: mapping.Code, ErrorDescription: description})
+}
@@ -0,0 +1,584 @@
+package api
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// OrganizationController handles the organizations and teams of the signed-in user.
+type OrganizationController struct {
+	organizationService *service.OrganizationService
+}
+
+// NewOrganizationController creates a new instance of the organization controller.
+func NewOrganizationController(organizationService *service.OrganizationService) *OrganizationController {
+	return &OrganizationController{
+		organizationService: organizationService,
+	}
+}
+
+// ListOrganizations handles the request to list the caller's organizations.
+// @Summary      List my organizations
+// @Description  Lists the organizations the signed-in user is a member of, with their role in each
+// @Tags         organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  api.ResponseModel{data=[]dto.OrganizationResponse}
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Not a signed-in user"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /orgs [get]
+func (c *OrganizationController) ListOrganizations(ctx fiber.Ctx) error {
+	var organizations []dto.OrganizationResponse
+	organizations, err := c.organizationService.List(ctx.Context(), currentUserID(ctx))
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetOrganizations)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.OrganizationsFetched),
+		organizations,
+	))
+}
+
+// CreateOrganization handles the request to create an organization.
+// @Summary      Create organization
+// @Description  Creates an organization owned by the signed-in user
+// @Tags         organizations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        organization  body      dto.OrganizationRequest  true  "Organization"
+// @Success      201           {object}  api.ResponseModel{data=dto.OrganizationResponse}
+// @Failure      400           {object}  api.Problem  "Invalid request"
+// @Failure      401           {object}  api.Problem  "Unauthorized"
+// @Failure      403           {object}  api.Problem  "Not a signed-in user"
+// @Failure      500           {object}  api.Problem  "Internal server error"
+// @Router       /orgs [post]
+func (c *OrganizationController) CreateOrganization(ctx fiber.Ctx) error {
+	var request dto.OrganizationRequest
+
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotCreateOrganization)
+	}
+
+	actor := common.Principal(ctx).Username
+	organization, err := c.organizationService.Create(ctx.Context(), currentUserID(ctx), request, actor, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotCreateOrganization)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewSuccessResponse(
+		Localize(ctx, constants.OrganizationCreated),
+		organization,
+	))
+}
+
+// GetOrganization handles the request to show an organization.
+// @Summary      Show organization
+// @Description  Retrieves an organization the signed-in user is a member of
+// @Tags         organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId  path      int  true  "Organization ID"
+// @Success      200    {object}  api.ResponseModel{data=dto.OrganizationResponse}
+// @Failure      400    {object}  api.Problem  "Invalid ID format"
+// @Failure      401    {object}  api.Problem  "Unauthorized"
+// @Failure      403    {object}  api.Problem  "Not a signed-in user"
+// @Failure      404    {object}  api.Problem  "Organization not found"
+// @Failure      500    {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId} [get]
+func (c *OrganizationController) GetOrganization(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	organization, err := c.organizationService.Get(ctx.Context(), currentUserID(ctx), ids[0])
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetOrganizations)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.OrganizationFound),
+		organization,
+	))
+}
+
+// UpdateOrganization handles the request to rename an organization.
+// @Summary      Update organization
+// @Description  Renames an organization (organization owners and admins)
+// @Tags         organizations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId         path      int                      true  "Organization ID"
+// @Param        organization  body      dto.OrganizationRequest  true  "Organization"
+// @Success      200           {object}  api.ResponseModel{data=dto.OrganizationResponse}
+// @Failure      400           {object}  api.Problem  "Invalid request"
+// @Failure      401           {object}  api.Problem  "Unauthorized"
+// @Failure      403           {object}  api.Problem  "Insufficient role"
+// @Failure      404           {object}  api.Problem  "Organization not found"
+// @Failure      500           {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId} [put]
+func (c *OrganizationController) UpdateOrganization(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var request dto.OrganizationRequest
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotUpdateOrganization)
+	}
+
+	organization, err := c.organizationService.Update(ctx.Context(), currentUserID(ctx), ids[0], request)
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotUpdateOrganization)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.OrganizationUpdated),
+		organization,
+	))
+}
+
+// DeleteOrganization handles the request to delete an organization.
+// @Summary      Delete organization
+// @Description  Deletes an organization with its teams and their invitations (organization owners)
+// @Tags         organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId  path      int  true  "Organization ID"
+// @Success      200    {object}  api.ResponseModel
+// @Failure      400    {object}  api.Problem  "Invalid ID format"
+// @Failure      401    {object}  api.Problem  "Unauthorized"
+// @Failure      403    {object}  api.Problem  "Insufficient role"
+// @Failure      404    {object}  api.Problem  "Organization not found"
+// @Failure      500    {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId} [delete]
+func (c *OrganizationController) DeleteOrganization(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	actor := common.Principal(ctx).Username
+	if err := c.organizationService.Delete(ctx.Context(), currentUserID(ctx), ids[0], actor, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotDeleteOrganization)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.OrganizationDeleted),
+		nil,
+	))
+}
+
+// ListMembers handles the request to list the members of an organization.
+// @Summary      List organization members
+// @Description  Lists the members of an organization the signed-in user is a member of, with their roles
+// @Tags         organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId  path      int  true  "Organization ID"
+// @Success      200    {object}  api.ResponseModel{data=[]dto.MemberResponse}
+// @Failure      400    {object}  api.Problem  "Invalid ID format"
+// @Failure      401    {object}  api.Problem  "Unauthorized"
+// @Failure      403    {object}  api.Problem  "Not a signed-in user"
+// @Failure      404    {object}  api.Problem  "Organization not found"
+// @Failure      500    {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/members [get]
+func (c *OrganizationController) ListMembers(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var members []dto.MemberResponse
+	members, err = c.organizationService.ListMembers(ctx.Context(), currentUserID(ctx), ids[0])
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetMembers)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.MembersFetched),
+		members,
+	))
+}
+
+// ChangeMemberRole handles the request to change the role of an organization member.
+// @Summary      Change organization member role
+// @Description  Changes the role of a member of an organization (organization owners). The last owner cannot be demoted
+// @Tags         organizations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId   path      int                    true  "Organization ID"
+// @Param        userId  path      int                    true  "User ID of the member"
+// @Param        role    body      dto.MemberRoleRequest  true  "Role"
+// @Success      200     {object}  api.ResponseModel{data=dto.MemberResponse}
+// @Failure      400     {object}  api.Problem  "Invalid request"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient role"
+// @Failure      404     {object}  api.Problem  "Organization or member not found"
+// @Failure      409     {object}  api.Problem  "Last owner"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/members/{userId} [put]
+func (c *OrganizationController) ChangeMemberRole(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "userId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var request dto.MemberRoleRequest
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotChangeMemberRole)
+	}
+
+	actor := common.Principal(ctx).Username
+	member, err := c.organizationService.ChangeMemberRole(ctx.Context(), currentUserID(ctx), ids[0], ids[1], model.MemberRole(request.Role), actor, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotChangeMemberRole)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.MemberRoleChanged),
+		member,
+	))
+}
+
+// RemoveMember handles the request to remove a member from an organization.
+// @Summary      Remove organization member
+// @Description  Removes a member from an organization and all its teams. Organization owners and admins may remove members, though only owners may remove owners; members may remove themselves to leave. The last owner cannot leave
+// @Tags         organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId   path      int  true  "Organization ID"
+// @Param        userId  path      int  true  "User ID of the member"
+// @Success      200     {object}  api.ResponseModel
+// @Failure      400     {object}  api.Problem  "Invalid ID format"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient role"
+// @Failure      404     {object}  api.Problem  "Organization or member not found"
+// @Failure      409     {object}  api.Problem  "Last owner of the organization or one of its teams"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/members/{userId} [delete]
+func (c *OrganizationController) RemoveMember(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "userId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	actor := common.Principal(ctx).Username
+	if err := c.organizationService.RemoveMember(ctx.Context(), currentUserID(ctx), ids[0], ids[1], actor, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotRemoveMember)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.MemberRemoved),
+		nil,
+	))
+}
+
+// ListTeams handles the request to list the teams of an organization.
+// @Summary      List teams
+// @Description  Lists the teams of an organization the signed-in user is a member of, with their role in each
+// @Tags         teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId  path      int  true  "Organization ID"
+// @Success      200    {object}  api.ResponseModel{data=[]dto.TeamResponse}
+// @Failure      400    {object}  api.Problem  "Invalid ID format"
+// @Failure      401    {object}  api.Problem  "Unauthorized"
+// @Failure      403    {object}  api.Problem  "Not a signed-in user"
+// @Failure      404    {object}  api.Problem  "Organization not found"
+// @Failure      500    {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams [get]
+func (c *OrganizationController) ListTeams(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var teams []dto.TeamResponse
+	teams, err = c.organizationService.ListTeams(ctx.Context(), currentUserID(ctx), ids[0])
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetTeams)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.TeamsFetched),
+		teams,
+	))
+}
+
+// CreateTeam handles the request to create a team.
+// @Summary      Create team
+// @Description  Creates a team in an organization, owned by the signed-in user (organization owners and admins)
+// @Tags         teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId  path      int              true  "Organization ID"
+// @Param        team   body      dto.TeamRequest  true  "Team"
+// @Success      201    {object}  api.ResponseModel{data=dto.TeamResponse}
+// @Failure      400    {object}  api.Problem  "Invalid request"
+// @Failure      401    {object}  api.Problem  "Unauthorized"
+// @Failure      403    {object}  api.Problem  "Insufficient role"
+// @Failure      404    {object}  api.Problem  "Organization not found"
+// @Failure      500    {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams [post]
+func (c *OrganizationController) CreateTeam(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var request dto.TeamRequest
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotCreateTeam)
+	}
+
+	actor := common.Principal(ctx).Username
+	team, err := c.organizationService.CreateTeam(ctx.Context(), currentUserID(ctx), ids[0], request, actor, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotCreateTeam)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewSuccessResponse(
+		Localize(ctx, constants.TeamCreated),
+		team,
+	))
+}
+
+// GetTeam handles the request to show a team.
+// @Summary      Show team
+// @Description  Retrieves a team of an organization the signed-in user is a member of
+// @Tags         teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId   path      int  true  "Organization ID"
+// @Param        teamId  path      int  true  "Team ID"
+// @Success      200     {object}  api.ResponseModel{data=dto.TeamResponse}
+// @Failure      400     {object}  api.Problem  "Invalid ID format"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Not a signed-in user"
+// @Failure      404     {object}  api.Problem  "Organization or team not found"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams/{teamId} [get]
+func (c *OrganizationController) GetTeam(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "teamId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	team, err := c.organizationService.GetTeam(ctx.Context(), currentUserID(ctx), ids[0], ids[1])
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetTeams)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.TeamFound),
+		team,
+	))
+}
+
+// UpdateTeam handles the request to rename a team.
+// @Summary      Update team
+// @Description  Renames a team (team owners and admins)
+// @Tags         teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId   path      int              true  "Organization ID"
+// @Param        teamId  path      int              true  "Team ID"
+// @Param        team    body      dto.TeamRequest  true  "Team"
+// @Success      200     {object}  api.ResponseModel{data=dto.TeamResponse}
+// @Failure      400     {object}  api.Problem  "Invalid request"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient role"
+// @Failure      404     {object}  api.Problem  "Organization or team not found"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams/{teamId} [put]
+func (c *OrganizationController) UpdateTeam(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "teamId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var request dto.TeamRequest
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotUpdateTeam)
+	}
+
+	team, err := c.organizationService.UpdateTeam(ctx.Context(), currentUserID(ctx), ids[0], ids[1], request)
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotUpdateTeam)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.TeamUpdated),
+		team,
+	))
+}
+
+// DeleteTeam handles the request to delete a team.
+// @Summary      Delete team
+// @Description  Deletes a team with its invitations (team owners)
+// @Tags         teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId   path      int  true  "Organization ID"
+// @Param        teamId  path      int  true  "Team ID"
+// @Success      200     {object}  api.ResponseModel
+// @Failure      400     {object}  api.Problem  "Invalid ID format"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient role"
+// @Failure      404     {object}  api.Problem  "Organization or team not found"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams/{teamId} [delete]
+func (c *OrganizationController) DeleteTeam(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "teamId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	actor := common.Principal(ctx).Username
+	if err := c.organizationService.DeleteTeam(ctx.Context(), currentUserID(ctx), ids[0], ids[1], actor, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotDeleteTeam)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.TeamDeleted),
+		nil,
+	))
+}
+
+// ListTeamMembers handles the request to list the members of a team.
+// @Summary      List team members
+// @Description  Lists the members of a team of an organization the signed-in user is a member of, with their team roles
+// @Tags         teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId   path      int  true  "Organization ID"
+// @Param        teamId  path      int  true  "Team ID"
+// @Success      200     {object}  api.ResponseModel{data=[]dto.MemberResponse}
+// @Failure      400     {object}  api.Problem  "Invalid ID format"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Not a signed-in user"
+// @Failure      404     {object}  api.Problem  "Organization or team not found"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams/{teamId}/members [get]
+func (c *OrganizationController) ListTeamMembers(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "teamId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var members []dto.MemberResponse
+	members, err = c.organizationService.ListTeamMembers(ctx.Context(), currentUserID(ctx), ids[0], ids[1])
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetMembers)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.MembersFetched),
+		members,
+	))
+}
+
+// ChangeTeamMemberRole handles the request to change the role of a team member.
+// @Summary      Change team member role
+// @Description  Changes the role of a member of a team. Team owners and admins may, though only owners may change the role of an owner or make a member owner. The last owner cannot be demoted
+// @Tags         teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId   path      int                    true  "Organization ID"
+// @Param        teamId  path      int                    true  "Team ID"
+// @Param        userId  path      int                    true  "User ID of the member"
+// @Param        role    body      dto.MemberRoleRequest  true  "Role"
+// @Success      200     {object}  api.ResponseModel{data=dto.MemberResponse}
+// @Failure      400     {object}  api.Problem  "Invalid request"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient role"
+// @Failure      404     {object}  api.Problem  "Organization, team or member not found"
+// @Failure      409     {object}  api.Problem  "Last owner"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams/{teamId}/members/{userId} [put]
+func (c *OrganizationController) ChangeTeamMemberRole(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "teamId", "userId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	var request dto.MemberRoleRequest
+	if err := ValidateRequest(ctx, &request); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotChangeMemberRole)
+	}
+
+	actor := common.Principal(ctx).Username
+	member, err := c.organizationService.ChangeTeamMemberRole(ctx.Context(), currentUserID(ctx), ids[0], ids[1], ids[2], model.MemberRole(request.Role), actor, ctx.IP())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotChangeMemberRole)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.MemberRoleChanged),
+		member,
+	))
+}
+
+// RemoveTeamMember handles the request to remove a member from a team.
+// @Summary      Remove team member
+// @Description  Removes a member from a team; they stay a member of the organization. Team owners and admins may remove members, though only owners may remove owners; members may remove themselves to leave. The last owner cannot leave
+// @Tags         teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orgId   path      int  true  "Organization ID"
+// @Param        teamId  path      int  true  "Team ID"
+// @Param        userId  path      int  true  "User ID of the member"
+// @Success      200     {object}  api.ResponseModel
+// @Failure      400     {object}  api.Problem  "Invalid ID format"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient role"
+// @Failure      404     {object}  api.Problem  "Organization, team or member not found"
+// @Failure      409     {object}  api.Problem  "Last owner"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /orgs/{orgId}/teams/{teamId}/members/{userId} [delete]
+func (c *OrganizationController) RemoveTeamMember(ctx fiber.Ctx) error {
+	ids, err := idParams(ctx, "orgId", "teamId", "userId")
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	actor := common.Principal(ctx).Username
+	if err := c.organizationService.RemoveTeamMember(ctx.Context(), currentUserID(ctx), ids[0], ids[1], ids[2], actor, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotRemoveMember)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.MemberRemoved),
+		nil,
+	))
+}
+
+// idParams parses the integer route parameters with the given names, in order
+func idParams(ctx fiber.Ctx, names ...string) ([]int, error) {
+	ids := make([]int, len(names))
+	for i, name := range names {
+		id, err := strconv.Atoi(ctx.Params(name))
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// ForgotPasswordRequest identifies the account whose password was forgotten.
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ResetPasswordRequest carries the token from a reset link and the new password.
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required"` // Checked against the password policy
+}
+
+// PasswordController handles password reset requests.
+type PasswordController struct {
+	passwordService *service.PasswordService
+}
+
+// NewPasswordController creates a new instance of the password controller.
+func NewPasswordController(passwordService *service.PasswordService) *PasswordController {
+	return &PasswordController{
+		passwordService: passwordService,
+	}
+}
+
+// Forgot sends a password reset link.
+// @Summary      Request password reset
+// @Description  Sends a password reset link if the address belongs to an account. Always responds with 202 so it can't be used to discover accounts
+// @Tags         password
+// @Accept       json
+// @Produce      json
+// @Param        request  body      api.ForgotPasswordRequest  true  "Email address"
+// @Success      202      {object}  api.ResponseModel
+// @Failure      400      {object}  api.Problem  "Invalid request"
+// @Failure      429      {object}  api.Problem  "Rate limit exceeded"
+// @Failure      500      {object}  api.Problem  "Internal server error"
+// @Router       /password/forgot [post]
+func (c *PasswordController) Forgot(ctx fiber.Ctx) error {
+	var req ForgotPasswordRequest
+
+	if err := ValidateRequest(ctx, &req); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotRequestReset)
+	}
+
+	if err := c.passwordService.Forgot(ctx.Context(), req.Email, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotRequestReset)
+	}
+
+	return ctx.Status(fiber.StatusAccepted).JSON(NewSuccessResponse(
+		Localize(ctx, constants.PasswordResetRequested),
+		nil,
+	))
+}
+
+// Reset sets a new password with the token from a reset link.
+// @Summary      Reset password
+// @Description  Sets a new password with the single-use token sent in the reset link. Tokens issued before the reset stop working
+// @Tags         password
+// @Accept       json
+// @Produce      json
+// @Param        request  body      api.ResetPasswordRequest  true  "Reset token and new password"
+// @Success      200      {object}  api.ResponseModel
+// @Failure      400      {object}  api.Problem  "Invalid request or token, or the password violates the password policy"
+// @Failure      429      {object}  api.Problem  "Rate limit exceeded"
+// @Failure      500      {object}  api.Problem  "Internal server error"
+// @Router       /password/reset [post]
+func (c *PasswordController) Reset(ctx fiber.Ctx) error {
+	var req ResetPasswordRequest
+
+	if err := ValidateRequest(ctx, &req); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotResetPassword)
+	}
+
+	if err := c.passwordService.Reset(ctx.Context(), req.Token, req.Password, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotResetPassword)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.PasswordResetSuccess),
+		nil,
+	))
+}
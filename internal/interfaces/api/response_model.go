@@ -1,6 +1,10 @@
 package api
 
-import "mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+
+	"github.com/gofiber/fiber/v3"
+)
 
 // ResponseModel is the standard structure used for all API responses.
 // It is defined as a type alias to support Swagger documentation.
@@ -17,3 +21,17 @@ func NewSuccessResponse(message string, data interface{}) common.ResponseModel {
 func NewErrorResponse(message, details string) common.ResponseModel {
 	return common.NewErrorResponse(message, details)
 }
+
+// Problem is the RFC 7807 problem details structure used for error responses.
+// It is defined as a type alias to support Swagger documentation.
+type Problem = common.Problem
+
+// SendError writes an error response, negotiating between problem details and the legacy envelope.
+func SendError(c fiber.Ctx, status int, code, title, detail string) error {
+	return common.SendError(c, status, code, title, detail)
+}
+
+// Localize translates a message into the request's locale, formatted with args if any.
+func Localize(c fiber.Ctx, message string, args ...any) string {
+	return common.Localize(c, message, args...)
+}
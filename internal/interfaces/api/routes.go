@@ -1,11 +1,28 @@
 package api
 
 import (
+	"time"
+
 	"mcanvr/example-golang-api-with-fiber/internal/config"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/middleware"
 
 	"github.com/gofiber/fiber/v3"
 )
 
+// RateLimitPolicies builds the rate limit policies from the configuration:
+// a broad global limit, strict limits on login and generous limits on reads.
+// The global limit runs before authentication, so it counts requests by IP address.
+func RateLimitPolicies(cfg *config.Config) []middleware.RateLimitPolicy {
+	window := time.Duration(cfg.RateLimitWindowSec) * time.Second
+	return []middleware.RateLimitPolicy{
+		{Name: "global", Max: cfg.RateLimitGlobalMax, Window: window, ByIP: true},
+		{Name: "login", Max: cfg.RateLimitLoginMax, Window: window},
+		{Name: "read", Max: cfg.RateLimitReadMax, Window: window},
+		{Name: "write", Max: cfg.RateLimitWriteMax, Window: window},
+	}
+}
+
 // SetupRoutes configures all API routes for the application
 // It groups routes logically and applies appropriate middleware
 func SetupRoutes(
@@ -13,23 +30,150 @@ func SetupRoutes(
 	cfg *config.Config,
 	userController *UserController,
 	authController *AuthController,
+	emailController *EmailController,
+	passwordController *PasswordController,
+	mfaController *MFAController,
+	apiKeyController *APIKeyController,
+	oauthController *OAuthController,
+	impersonationController *ImpersonationController,
+	sessionController *SessionController,
+	organizationController *OrganizationController,
+	invitationController *InvitationController,
 	jwtMiddleware fiber.Handler,
+	jwtOrAPIKey fiber.Handler,
+	rateLimiter *middleware.RateLimiter,
 ) {
+	// Rate limit policies, see RateLimitPolicies
+	loginLimit := rateLimiter.Limit("login")
+	readLimit := rateLimiter.Limit("read")
+	writeLimit := rateLimiter.Limit("write")
+
+	// Scopes a token, API key or OAuth client needs to read or change users
+	readScope := middleware.RequireScopes(model.ScopeUsersRead)
+	writeScope := middleware.RequireScopes(model.ScopeUsersWrite)
+
 	// API group with version
 	api := app.Group("/api")
 	v1 := api.Group("/v1")
 
 	// Authentication routes - public access
-	v1.Post("/login", authController.Login)
+	v1.Post("/login", authController.Login, loginLimit)
+	v1.Post("/login/mfa", authController.LoginMFA, loginLimit)
+	v1.Post("/login/refresh", authController.Refresh, loginLimit)
+
+	// OAuth2 endpoints - clients authenticate with their own credentials, limited like logins
+	oauth := v1.Group("/oauth")
+	oauth.Post("/token", oauthController.Token, loginLimit)
+	oauth.Post("/introspect", oauthController.Introspect, loginLimit)
+	oauth.Post("/revoke", oauthController.Revoke, loginLimit)
+
+	// Email verification routes - public access, resending is limited like logins
+	email := v1.Group("/email")
+	email.Post("/verify", emailController.Verify, writeLimit)
+	email.Post("/verify/resend", emailController.Resend, loginLimit)
+
+	// Password reset routes - public access, limited like logins
+	password := v1.Group("/password")
+	password.Post("/forgot", passwordController.Forgot, loginLimit)
+	password.Post("/reset", passwordController.Reset, loginLimit)
+
+	// MFA settings of the signed-in user - protected with JWT authentication, not for impersonation tokens
+	mfa := v1.Group("/mfa")
+	mfa.Use(jwtMiddleware)
+	mfa.Use(middleware.DenyImpersonation())
+	mfa.Post("/enroll", mfaController.Enroll, writeLimit)
+	mfa.Post("/confirm", mfaController.Confirm, writeLimit)
+	mfa.Post("/disable", mfaController.Disable, writeLimit)
 
-	// User routes - protected with JWT authentication
+	// Account of the signed-in user - protected with JWT authentication, not for OAuth client tokens,
+	// and needing the matching scope, so a read-only token cannot change the account
+	me := v1.Group("/me")
+	me.Use(jwtMiddleware)
+	me.Use(middleware.UsersOnly())
+	me.Get("/", userController.GetMe, readScope, readLimit)
+	me.Patch("/", userController.UpdateMe, writeScope, writeLimit)
+	me.Delete("/", userController.DeleteMe, writeScope, middleware.DenyImpersonation(), writeLimit)
+	me.Post("/password", userController.ChangeMyPassword, writeScope, middleware.DenyImpersonation(), loginLimit)
+	me.Get("/sessions", sessionController.ListMySessions, readScope, readLimit)
+	me.Delete("/sessions/:id", sessionController.RevokeMySession, writeScope, middleware.DenyImpersonation(), writeLimit)
+
+	// Organizations and teams of the signed-in user - protected with JWT authentication, not for OAuth client tokens.
+	// Access to each organization and team is decided by the user's membership and role in it
+	orgs := v1.Group("/orgs")
+	orgs.Use(jwtMiddleware)
+	orgs.Use(middleware.UsersOnly())
+	orgs.Post("/invitations/accept", invitationController.AcceptInvitation, writeLimit)
+	orgs.Post("/invitations/decline", invitationController.DeclineInvitation, writeLimit)
+	orgs.Get("/", organizationController.ListOrganizations, readLimit)
+	orgs.Post("/", organizationController.CreateOrganization, writeLimit)
+	orgs.Get("/:orgId", organizationController.GetOrganization, readLimit)
+	orgs.Put("/:orgId", organizationController.UpdateOrganization, writeLimit)
+	orgs.Delete("/:orgId", organizationController.DeleteOrganization, writeLimit)
+	orgs.Get("/:orgId/members", organizationController.ListMembers, readLimit)
+	orgs.Put("/:orgId/members/:userId", organizationController.ChangeMemberRole, writeLimit)
+	orgs.Delete("/:orgId/members/:userId", organizationController.RemoveMember, writeLimit)
+
+	// Teams of an organization, their members and invitations
+	orgs.Get("/:orgId/teams", organizationController.ListTeams, readLimit)
+	orgs.Post("/:orgId/teams", organizationController.CreateTeam, writeLimit)
+	orgs.Get("/:orgId/teams/:teamId", organizationController.GetTeam, readLimit)
+	orgs.Put("/:orgId/teams/:teamId", organizationController.UpdateTeam, writeLimit)
+	orgs.Delete("/:orgId/teams/:teamId", organizationController.DeleteTeam, writeLimit)
+	orgs.Get("/:orgId/teams/:teamId/members", organizationController.ListTeamMembers, readLimit)
+	orgs.Put("/:orgId/teams/:teamId/members/:userId", organizationController.ChangeTeamMemberRole, writeLimit)
+	orgs.Delete("/:orgId/teams/:teamId/members/:userId", organizationController.RemoveTeamMember, writeLimit)
+	orgs.Get("/:orgId/teams/:teamId/invitations", invitationController.ListInvitations, readLimit)
+	orgs.Post("/:orgId/teams/:teamId/invitations", invitationController.CreateInvitation, writeLimit)
+	orgs.Delete("/:orgId/teams/:teamId/invitations/:invitationId", invitationController.CancelInvitation, writeLimit)
+
+	// Admin-only routes, which may also require an MFA sign-in
+	adminOnly := []fiber.Handler{middleware.AdminOnly()}
+	if cfg.MFARequiredAdmin {
+		adminOnly = append(adminOnly, middleware.MFARequired())
+	}
+
+	// Admin routes - protected with JWT authentication and restricted to administrators,
+	// whose token needs the matching scope, so a read-only admin token can only list
+	admin := v1.Group("/admin")
+	admin.Use(jwtMiddleware)
+	for _, handler := range adminOnly {
+		admin.Use(handler)
+	}
+	admin.Post("/unlock", authController.Unlock, writeScope, writeLimit)
+	admin.Post("/impersonate/:id", impersonationController.Impersonate, writeScope, writeLimit)
+
+	// Session administration
+	admin.Get("/users/:id/sessions", sessionController.ListUserSessions, readScope, readLimit)
+	admin.Delete("/users/:id/sessions/:sessionId", sessionController.RevokeUserSession, writeScope, writeLimit)
+
+	// API key administration
+	admin.Get("/api-keys", apiKeyController.ListAPIKeys, readScope, readLimit)
+	admin.Post("/api-keys", apiKeyController.CreateAPIKey, writeScope, writeLimit)
+	admin.Delete("/api-keys/:id", apiKeyController.RevokeAPIKey, writeScope, writeLimit)
+
+	// OAuth client administration
+	admin.Get("/oauth-clients", oauthController.ListClients, readScope, readLimit)
+	admin.Post("/oauth-clients", oauthController.CreateClient, writeScope, writeLimit)
+	admin.Delete("/oauth-clients/:id", oauthController.DeleteClient, writeScope, writeLimit)
+
+	// User routes - protected with JWT or API key authentication, API keys and OAuth clients need the matching scope
 	users := v1.Group("/users")
-	users.Use(jwtMiddleware)
+	users.Use(jwtOrAPIKey)
 
 	// User CRUD operations
-	users.Get("/", userController.GetUsers)
-	users.Post("/", userController.CreateUser)
-	users.Get("/:id", userController.GetUserByID)
-	users.Put("/:id", userController.UpdateUser)
-	users.Delete("/:id", userController.DeleteUser)
+	users.Get("/", userController.GetUsers, readScope, readLimit)
+	users.Post("/", userController.CreateUser, writeScope, writeLimit)
+	users.Get("/:id", userController.GetUserByID, readScope, readLimit)
+	users.Put("/:id", userController.UpdateUser, writeScope, writeLimit)
+	users.Delete("/:id", userController.DeleteUser, writeScope, writeLimit)
+
+	// User lifecycle transitions are reserved for administrators
+	// Capped at its length, as registering a route appends its handler to the slice
+	transition := append([]fiber.Handler{writeScope}, adminOnly...)
+	transition = append(transition, writeLimit)
+	transition = transition[:len(transition):len(transition)]
+	users.Post("/:id/activate", userController.ActivateUser, transition...)
+	users.Post("/:id/suspend", userController.SuspendUser, transition...)
+	users.Post("/:id/deactivate", userController.DeactivateUser, transition...)
+	users.Post("/:id/reactivate", userController.ReactivateUser, transition...)
 }
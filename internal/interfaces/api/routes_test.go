@@ -0,0 +1,110 @@
+package api
+
+import (
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/config"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/ratelimit"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/middleware"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// newTestRoutes returns the application routes with an authentication stub, which
+// signs every request in as the given principal. The controllers are left out,
+// so requests that reach a handler fail instead of succeeding
+func newTestRoutes(principal *service.Principal) *fiber.App {
+	authenticate := func(c fiber.Ctx) error {
+		common.SetPrincipal(c, principal)
+		return c.Next()
+	}
+
+	app := fiber.New()
+	app.Use(middleware.Recover())
+	SetupRoutes(app, &config.Config{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		authenticate, authenticate, middleware.NewRateLimiter(ratelimit.NewMemoryStore()))
+	return app
+}
+
+// scopesExcept returns every scope but the given one
+func scopesExcept(scope string) []string {
+	return slices.DeleteFunc(slices.Clone(model.Scopes), func(s string) bool { return s == scope })
+}
+
+func TestAdminRouteScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		scope  string
+	}{
+		{"Unlock", fiber.MethodPost, "/api/v1/admin/unlock", "users:write"},
+		{"Impersonate", fiber.MethodPost, "/api/v1/admin/impersonate/2", "users:write"},
+		{"List Sessions", fiber.MethodGet, "/api/v1/admin/users/2/sessions", "users:read"},
+		{"Revoke Session", fiber.MethodDelete, "/api/v1/admin/users/2/sessions/abc", "users:write"},
+		{"List API Keys", fiber.MethodGet, "/api/v1/admin/api-keys", "users:read"},
+		{"Create API Key", fiber.MethodPost, "/api/v1/admin/api-keys", "users:write"},
+		{"Revoke API Key", fiber.MethodDelete, "/api/v1/admin/api-keys/1", "users:write"},
+		{"List OAuth Clients", fiber.MethodGet, "/api/v1/admin/oauth-clients", "users:read"},
+		{"Create OAuth Client", fiber.MethodPost, "/api/v1/admin/oauth-clients", "users:write"},
+		{"Delete OAuth Client", fiber.MethodDelete, "/api/v1/admin/oauth-clients/1", "users:write"},
+	}
+
+	// An administrator token granted every scope except the one the route needs
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTestRoutes(&service.Principal{UserID: 5, Username: "admin", Admin: true, Scopes: scopesExcept(tt.scope)})
+
+			resp, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil))
+			if err != nil {
+				t.Fatalf("app.Test() unexpected error = %v", err)
+			}
+			if resp.StatusCode != fiber.StatusForbidden {
+				t.Errorf("status = %v, want %v", resp.StatusCode, fiber.StatusForbidden)
+			}
+			if challenge := resp.Header.Get(fiber.HeaderWWWAuthenticate); !strings.Contains(challenge, `scope="`+tt.scope+`"`) {
+				t.Errorf("WWW-Authenticate = %q, want insufficient scope %s", challenge, tt.scope)
+			}
+		})
+	}
+}
+
+func TestMeRouteScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		scope  string
+	}{
+		{"Get Profile", fiber.MethodGet, "/api/v1/me", "users:read"},
+		{"Update Profile", fiber.MethodPatch, "/api/v1/me", "users:write"},
+		{"Delete Account", fiber.MethodDelete, "/api/v1/me", "users:write"},
+		{"Change Password", fiber.MethodPost, "/api/v1/me/password", "users:write"},
+		{"List Sessions", fiber.MethodGet, "/api/v1/me/sessions", "users:read"},
+		{"Revoke Session", fiber.MethodDelete, "/api/v1/me/sessions/abc", "users:write"},
+	}
+
+	// A user token granted every scope except the one the route needs
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTestRoutes(&service.Principal{UserID: 2, Username: "jane@example.com", Scopes: scopesExcept(tt.scope)})
+
+			resp, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil))
+			if err != nil {
+				t.Fatalf("app.Test() unexpected error = %v", err)
+			}
+			if resp.StatusCode != fiber.StatusForbidden {
+				t.Errorf("status = %v, want %v", resp.StatusCode, fiber.StatusForbidden)
+			}
+			if challenge := resp.Header.Get(fiber.HeaderWWWAuthenticate); !strings.Contains(challenge, `scope="`+tt.scope+`"`) {
+				t.Errorf("WWW-Authenticate = %q, want insufficient scope %s", challenge, tt.scope)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// SessionController handles the devices users are signed in on.
+type SessionController struct {
+	sessionService *service.SessionService
+}
+
+// NewSessionController creates a new instance of the session controller.
+func NewSessionController(sessionService *service.SessionService) *SessionController {
+	return &SessionController{
+		sessionService: sessionService,
+	}
+}
+
+// ListMySessions handles the request to list the caller's sessions.
+// @Summary      List my sessions
+// @Description  Lists the devices the signed-in user is signed in on, flagging the session of the request as current
+// @Tags         sessions
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  api.ResponseModel{data=[]dto.SessionResponse}
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope or not a signed-in user"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /me/sessions [get]
+func (c *SessionController) ListMySessions(ctx fiber.Ctx) error {
+	principal := common.Principal(ctx)
+	return c.listSessions(ctx, principal.UserID, principal.SessionID)
+}
+
+// RevokeMySession handles the request to sign out one of the caller's devices.
+// @Summary      Revoke my session
+// @Description  Signs the signed-in user out of a device: the session's refresh token stops working and its access tokens are rejected. Not allowed while impersonating
+// @Tags         sessions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "Session ID"
+// @Success      200  {object}  api.ResponseModel
+// @Failure      400  {object}  api.Problem  "Invalid ID format"
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope, not a signed-in user, or impersonating"
+// @Failure      404  {object}  api.Problem  "Session not found"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /me/sessions/{id} [delete]
+func (c *SessionController) RevokeMySession(ctx fiber.Ctx) error {
+	return c.revokeSession(ctx, currentUserID(ctx), "id")
+}
+
+// ListUserSessions handles the request to list a user's sessions.
+// @Summary      List user sessions
+// @Description  Lists the devices a user is signed in on (admin only)
+// @Tags         sessions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "User ID"
+// @Success      200  {object}  api.ResponseModel{data=[]dto.SessionResponse}
+// @Failure      400  {object}  api.Problem  "Invalid ID format"
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      404  {object}  api.Problem  "User not found"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /admin/users/{id}/sessions [get]
+func (c *SessionController) ListUserSessions(ctx fiber.Ctx) error {
+	userID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	return c.listSessions(ctx, userID, common.Principal(ctx).SessionID)
+}
+
+// RevokeUserSession handles the request to sign a user out of a device.
+// @Summary      Revoke user session
+// @Description  Signs a user out of a device: the session's refresh token stops working and its access tokens are rejected (admin only)
+// @Tags         sessions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id         path      int  true  "User ID"
+// @Param        sessionId  path      int  true  "Session ID"
+// @Success      200        {object}  api.ResponseModel
+// @Failure      400        {object}  api.Problem  "Invalid ID format"
+// @Failure      401        {object}  api.Problem  "Unauthorized"
+// @Failure      403        {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      404        {object}  api.Problem  "Session not found"
+// @Failure      500        {object}  api.Problem  "Internal server error"
+// @Router       /admin/users/{id}/sessions/{sessionId} [delete]
+func (c *SessionController) RevokeUserSession(ctx fiber.Ctx) error {
+	userID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	return c.revokeSession(ctx, userID, "sessionId")
+}
+
+// listSessions responds with the active sessions of a user
+func (c *SessionController) listSessions(ctx fiber.Ctx, userID, currentID int) error {
+	var sessions []dto.SessionResponse
+	sessions, err := c.sessionService.List(ctx.Context(), userID, currentID)
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetSessions)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.SessionsFetched),
+		sessions,
+	))
+}
+
+// revokeSession revokes the session of a user named by the route parameter param
+func (c *SessionController) revokeSession(ctx fiber.Ctx, userID int, param string) error {
+	sessionID, err := strconv.Atoi(ctx.Params(param))
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	actor := common.Principal(ctx).Username
+	if err := c.sessionService.Revoke(ctx.Context(), userID, sessionID, actor, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotRevokeSession)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.SessionRevoked),
+		nil,
+	))
+}
@@ -3,7 +3,10 @@ package api
 import (
 	"mcanvr/example-golang-api-with-fiber/internal/application/dto"
 	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
 	"strconv"
 
 	"github.com/gofiber/fiber/v3"
@@ -29,9 +32,11 @@ func NewUserController(userAppService *service.UserApplicationService) *UserCont
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Success      200  {object}  api.ResponseModel{data=[]dto.UserResponse}
-// @Failure      401  {object}  api.ResponseModel  "Unauthorized"
-// @Failure      500  {object}  api.ResponseModel  "Internal server error"
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope"
+// @Failure      500  {object}  api.Problem  "Internal server error"
 // @Router       /users [get]
 func (c *UserController) GetUsers(ctx fiber.Ctx) error {
 	users, err := c.userAppService.GetAllUsers(ctx.Context())
@@ -40,7 +45,7 @@ func (c *UserController) GetUsers(ctx fiber.Ctx) error {
 	}
 
 	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
-		constants.UsersFetched,
+		Localize(ctx, constants.UsersFetched),
 		users,
 	))
 }
@@ -52,20 +57,19 @@ func (c *UserController) GetUsers(ctx fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Param        id   path      int  true  "User ID"
 // @Success      200  {object}  api.ResponseModel{data=dto.UserResponse}
-// @Failure      400  {object}  api.ResponseModel  "Invalid ID format"
-// @Failure      401  {object}  api.ResponseModel  "Unauthorized"
-// @Failure      404  {object}  api.ResponseModel  "User not found"
-// @Failure      500  {object}  api.ResponseModel  "Internal server error"
+// @Failure      400  {object}  api.Problem  "Invalid ID format"
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope"
+// @Failure      404  {object}  api.Problem  "User not found"
+// @Failure      500  {object}  api.Problem  "Internal server error"
 // @Router       /users/{id} [get]
 func (c *UserController) GetUserByID(ctx fiber.Ctx) error {
 	id, err := strconv.Atoi(ctx.Params("id"))
 	if err != nil {
-		return ctx.Status(fiber.StatusBadRequest).JSON(NewErrorResponse(
-			constants.InvalidIDFormat,
-			err.Error(),
-		))
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
 	}
 
 	user, err := c.userAppService.GetUserByID(ctx.Context(), id)
@@ -74,7 +78,7 @@ func (c *UserController) GetUserByID(ctx fiber.Ctx) error {
 	}
 
 	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
-		constants.UserFound,
+		Localize(ctx, constants.UserFound),
 		user,
 	))
 }
@@ -86,20 +90,19 @@ func (c *UserController) GetUserByID(ctx fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Param        user  body      dto.UserRequest  true  "User information"
 // @Success      201   {object}  api.ResponseModel{data=dto.UserResponse}
-// @Failure      400   {object}  api.ResponseModel  "Invalid request or user already exists"
-// @Failure      401   {object}  api.ResponseModel  "Unauthorized"
-// @Failure      500   {object}  api.ResponseModel  "Internal server error"
+// @Failure      400   {object}  api.Problem  "Invalid request or user already exists"
+// @Failure      401   {object}  api.Problem  "Unauthorized"
+// @Failure      403   {object}  api.Problem  "Insufficient scope"
+// @Failure      500   {object}  api.Problem  "Internal server error"
 // @Router       /users [post]
 func (c *UserController) CreateUser(ctx fiber.Ctx) error {
 	var userRequest dto.UserRequest
 
 	if err := ValidateRequest(ctx, &userRequest); err != nil {
-		return ctx.Status(err.Code).JSON(NewErrorResponse(
-			constants.CannotCreateUser,
-			err.Message,
-		))
+		return HandleDomainError(ctx, err, constants.CannotCreateUser)
 	}
 
 	user, err := c.userAppService.CreateUser(ctx.Context(), userRequest)
@@ -108,41 +111,37 @@ func (c *UserController) CreateUser(ctx fiber.Ctx) error {
 	}
 
 	return ctx.Status(fiber.StatusCreated).JSON(NewSuccessResponse(
-		constants.UserCreated,
+		Localize(ctx, constants.UserCreated),
 		user,
 	))
 }
 
 // UpdateUser handles the request to update an existing user.
 // @Summary      Update user
-// @Description  Updates a user by ID
+// @Description  Updates a user by ID. Signed-in users other than administrators can only update themselves, and only administrators can change the email address
 // @Tags         users
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Param        id    path      int             true  "User ID"
 // @Param        user  body      dto.UserRequest  true  "Updated user information"
 // @Success      200   {object}  api.ResponseModel{data=dto.UserResponse}
-// @Failure      400   {object}  api.ResponseModel  "Invalid request or email already used"
-// @Failure      401   {object}  api.ResponseModel  "Unauthorized"
-// @Failure      404   {object}  api.ResponseModel  "User not found"
-// @Failure      500   {object}  api.ResponseModel  "Internal server error"
+// @Failure      400   {object}  api.Problem  "Invalid request or email already used"
+// @Failure      401   {object}  api.Problem  "Unauthorized"
+// @Failure      403   {object}  api.Problem  "Insufficient scope, another user's record, or email change by a non-administrator"
+// @Failure      404   {object}  api.Problem  "User not found"
+// @Failure      500   {object}  api.Problem  "Internal server error"
 // @Router       /users/{id} [put]
 func (c *UserController) UpdateUser(ctx fiber.Ctx) error {
 	id, err := strconv.Atoi(ctx.Params("id"))
 	if err != nil {
-		return ctx.Status(fiber.StatusBadRequest).JSON(NewErrorResponse(
-			constants.InvalidIDFormat,
-			err.Error(),
-		))
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
 	}
 
 	var userRequest dto.UserRequest
 	if err := ValidateRequest(ctx, &userRequest); err != nil {
-		return ctx.Status(err.Code).JSON(NewErrorResponse(
-			constants.CannotUpdateUser,
-			err.Message,
-		))
+		return HandleDomainError(ctx, err, constants.CannotUpdateUser)
 	}
 
 	user, err := c.userAppService.UpdateUser(ctx.Context(), id, userRequest)
@@ -151,32 +150,31 @@ func (c *UserController) UpdateUser(ctx fiber.Ctx) error {
 	}
 
 	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
-		constants.UserUpdated,
+		Localize(ctx, constants.UserUpdated),
 		user,
 	))
 }
 
 // DeleteUser handles the request to delete a user.
 // @Summary      Delete user
-// @Description  Deletes a user by ID
+// @Description  Deletes a user by ID. Signed-in users other than administrators can only delete themselves
 // @Tags         users
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Param        id   path      int  true  "User ID"
 // @Success      204  {object}  api.ResponseModel
-// @Failure      400  {object}  api.ResponseModel  "Invalid ID format"
-// @Failure      401  {object}  api.ResponseModel  "Unauthorized"
-// @Failure      404  {object}  api.ResponseModel  "User not found"
-// @Failure      500  {object}  api.ResponseModel  "Internal server error"
+// @Failure      400  {object}  api.Problem  "Invalid ID format"
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope, another user's record, or impersonation"
+// @Failure      404  {object}  api.Problem  "User not found"
+// @Failure      500  {object}  api.Problem  "Internal server error"
 // @Router       /users/{id} [delete]
 func (c *UserController) DeleteUser(ctx fiber.Ctx) error {
 	id, err := strconv.Atoi(ctx.Params("id"))
 	if err != nil {
-		return ctx.Status(fiber.StatusBadRequest).JSON(NewErrorResponse(
-			constants.InvalidIDFormat,
-			err.Error(),
-		))
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
 	}
 
 	err = c.userAppService.DeleteUser(ctx.Context(), id)
@@ -185,7 +183,229 @@ func (c *UserController) DeleteUser(ctx fiber.Ctx) error {
 	}
 
 	return ctx.Status(fiber.StatusNoContent).JSON(NewSuccessResponse(
-		constants.UserDeleted,
+		Localize(ctx, constants.UserDeleted),
+		nil,
+	))
+}
+
+// ActivateUser handles the request to activate a user.
+// @Summary      Activate user
+// @Description  Activates a pending or suspended user. Requires administrator privileges
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id      path      int                    true   "User ID"
+// @Param        reason  body      dto.UserStatusRequest  false  "Optional reason"
+// @Success      200     {object}  api.ResponseModel{data=dto.UserResponse}
+// @Failure      400     {object}  api.Problem  "Invalid ID format or request"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      404     {object}  api.Problem  "User not found"
+// @Failure      409     {object}  api.Problem  "Transition not allowed from the user's current status"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /users/{id}/activate [post]
+func (c *UserController) ActivateUser(ctx fiber.Ctx) error {
+	return c.transitionUser(ctx, model.UserTransitionActivate, constants.UserActivated)
+}
+
+// SuspendUser handles the request to suspend a user.
+// @Summary      Suspend user
+// @Description  Suspends an active user. Suspended users cannot log in and their tokens are rejected. Requires administrator privileges
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id      path      int                    true   "User ID"
+// @Param        reason  body      dto.UserStatusRequest  false  "Optional reason"
+// @Success      200     {object}  api.ResponseModel{data=dto.UserResponse}
+// @Failure      400     {object}  api.Problem  "Invalid ID format or request"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      404     {object}  api.Problem  "User not found"
+// @Failure      409     {object}  api.Problem  "Transition not allowed from the user's current status"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /users/{id}/suspend [post]
+func (c *UserController) SuspendUser(ctx fiber.Ctx) error {
+	return c.transitionUser(ctx, model.UserTransitionSuspend, constants.UserSuspended)
+}
+
+// DeactivateUser handles the request to deactivate a user.
+// @Summary      Deactivate user
+// @Description  Deactivates a user, who can then only be reactivated. Requires administrator privileges
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id      path      int                    true   "User ID"
+// @Param        reason  body      dto.UserStatusRequest  false  "Optional reason"
+// @Success      200     {object}  api.ResponseModel{data=dto.UserResponse}
+// @Failure      400     {object}  api.Problem  "Invalid ID format or request"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Insufficient scope or not an administrator"
+// @Failure      404     {object}  api.Problem  "User not found"
+// @Failure      409     {object}  api.Problem  "Transition not allowed from the user's current status"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /users/{id}/deactivate [post]
+func (c *UserController) DeactivateUser(ctx fiber.Ctx) error {
+	return c.transitionUser(ctx, model.UserTransitionDeactivate, constants.UserDeactivated)
+}
+
+// ReactivateUser handles the request to reactivate a user.
+// @Summary      Reactivate user
+// @Description  Reactivates a deactivated user. Requires administrator privileges
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      int                    true   "User ID"
+// @Param        reason  body      dto.UserStatusRequest  false  "Optional reason"
+// @Success      200     {object}  api.ResponseModel{data=dto.UserResponse}
+// @Failure      400     {object}  api.Problem  "Invalid ID format or request"
+// @Failure      401     {object}  api.Problem  "Unauthorized"
+// @Failure      403     {object}  api.Problem  "Forbidden"
+// @Failure      404     {object}  api.Problem  "User not found"
+// @Failure      409     {object}  api.Problem  "Transition not allowed from the user's current status"
+// @Failure      500     {object}  api.Problem  "Internal server error"
+// @Router       /users/{id}/reactivate [post]
+func (c *UserController) ReactivateUser(ctx fiber.Ctx) error {
+	return c.transitionUser(ctx, model.UserTransitionReactivate, constants.UserReactivated)
+}
+
+// transitionUser applies a lifecycle transition to the user in the path,
+// recording the authenticated user as the actor.
+func (c *UserController) transitionUser(ctx fiber.Ctx, transition model.UserTransition, successMsg string) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return SendError(ctx, fiber.StatusBadRequest, appErrors.CodeInvalidRequest, constants.InvalidIDFormat, err.Error())
+	}
+
+	// The reason is optional, so the body may be empty
+	var statusRequest dto.UserStatusRequest
+	if len(ctx.Body()) > 0 {
+		if err := ValidateRequest(ctx, &statusRequest); err != nil {
+			return HandleDomainError(ctx, err, constants.CannotChangeStatus)
+		}
+	}
+
+	actor := common.Principal(ctx).Username
+	user, err := c.userAppService.TransitionUser(ctx.Context(), id, transition, actor, statusRequest.Reason)
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotChangeStatus)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, successMsg),
+		user,
+	))
+}
+
+// GetMe handles the request to retrieve the signed-in user's profile.
+// @Summary      Show my profile
+// @Description  Retrieves the profile of the signed-in user
+// @Tags         users
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  api.ResponseModel{data=dto.UserResponse}
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope or not a signed-in user"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /me [get]
+func (c *UserController) GetMe(ctx fiber.Ctx) error {
+	user, err := c.userAppService.GetCurrentUser(ctx.Context())
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotGetUsers)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.UserFound),
+		user,
+	))
+}
+
+// UpdateMe handles the request to update the signed-in user's profile.
+// @Summary      Update my profile
+// @Description  Updates the fields present in the request on the signed-in user's profile, leaving the others unchanged. A new email address must be verified again
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user  body      dto.UserPatchRequest  true  "Fields to update"
+// @Success      200   {object}  api.ResponseModel{data=dto.UserResponse}
+// @Failure      400   {object}  api.Problem  "Invalid request or email already used"
+// @Failure      401   {object}  api.Problem  "Unauthorized"
+// @Failure      403   {object}  api.Problem  "Insufficient scope, not a signed-in user, or changing the email address while impersonating"
+// @Failure      500   {object}  api.Problem  "Internal server error"
+// @Router       /me [patch]
+func (c *UserController) UpdateMe(ctx fiber.Ctx) error {
+	var patchRequest dto.UserPatchRequest
+	if err := ValidateRequest(ctx, &patchRequest); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotUpdateUser)
+	}
+
+	user, err := c.userAppService.UpdateCurrentUser(ctx.Context(), patchRequest)
+	if err != nil {
+		return HandleDomainError(ctx, err, constants.CannotUpdateUser)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.UserUpdated),
+		user,
+	))
+}
+
+// ChangeMyPassword handles the request to change the signed-in user's password.
+// @Summary      Change my password
+// @Description  Changes the signed-in user's password after checking the current one. The new password must satisfy the password policy. Every session and token issued before the change is revoked, including the one making the request. Not allowed while impersonating
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        password  body      dto.PasswordChangeRequest  true  "Current and new password"
+// @Success      200       {object}  api.ResponseModel
+// @Failure      400       {object}  api.Problem  "Invalid request, incorrect current password or password policy violation"
+// @Failure      401       {object}  api.Problem  "Unauthorized"
+// @Failure      403       {object}  api.Problem  "Insufficient scope, not a signed-in user, or impersonating"
+// @Failure      429       {object}  api.Problem  "Rate limit exceeded"
+// @Failure      500       {object}  api.Problem  "Internal server error"
+// @Router       /me/password [post]
+func (c *UserController) ChangeMyPassword(ctx fiber.Ctx) error {
+	var passwordRequest dto.PasswordChangeRequest
+	if err := ValidateRequest(ctx, &passwordRequest); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotChangePassword)
+	}
+
+	if err := c.userAppService.ChangeCurrentUserPassword(ctx.Context(), passwordRequest, ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotChangePassword)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewSuccessResponse(
+		Localize(ctx, constants.PasswordChanged),
+		nil,
+	))
+}
+
+// DeleteMe handles the request to delete the signed-in user's account.
+// @Summary      Delete my account
+// @Description  Deletes the signed-in user's account, signing them out everywhere. Not allowed while impersonating
+// @Tags         users
+// @Produce      json
+// @Security     BearerAuth
+// @Success      204  {object}  api.ResponseModel
+// @Failure      401  {object}  api.Problem  "Unauthorized"
+// @Failure      403  {object}  api.Problem  "Insufficient scope, not a signed-in user, or impersonating"
+// @Failure      500  {object}  api.Problem  "Internal server error"
+// @Router       /me [delete]
+func (c *UserController) DeleteMe(ctx fiber.Ctx) error {
+	if err := c.userAppService.DeleteCurrentUser(ctx.Context(), ctx.IP()); err != nil {
+		return HandleDomainError(ctx, err, constants.CannotDeleteAccount)
+	}
+
+	return ctx.Status(fiber.StatusNoContent).JSON(NewSuccessResponse(
+		Localize(ctx, constants.AccountDeleted),
 		nil,
 	))
 }
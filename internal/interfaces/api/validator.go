@@ -2,53 +2,138 @@ package api
 
 import (
 	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/i18n"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"reflect"
 	"strings"
 
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/tr"
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	enTranslations "github.com/go-playground/validator/v10/translations/en"
+	trTranslations "github.com/go-playground/validator/v10/translations/tr"
 	"github.com/gofiber/fiber/v3"
 )
 
-// Create a single validator instance to be reused
-var validate = validator.New()
+// validatorLocale pairs a locale with the validator's built-in translations for it
+type validatorLocale struct {
+	translator locales.Translator
+	register   func(v *validator.Validate, trans ut.Translator) error
+}
+
+// validatorLocales lists the built-in validator translations of each supported locale.
+// Locales missing here use the English validator messages for rules without a catalog entry.
+var validatorLocales = map[string]validatorLocale{
+	"en": {en.New(), enTranslations.RegisterDefaultTranslations},
+	"tr": {tr.New(), trTranslations.RegisterDefaultTranslations},
+}
+
+// fieldMessages maps validation rules to catalog messages formatted with the field
+// name and rule parameter. They take precedence over the built-in translations.
+var fieldMessages = map[string]string{
+	"required":           constants.FieldRequired,
+	"email":              constants.FieldInvalidEmail,
+	"min":                constants.FieldMinLength,
+	"max":                constants.FieldMaxLength,
+	"gte":                constants.FieldMinValue,
+	"lte":                constants.FieldMaxValue,
+	"e164":               constants.FieldInvalidPhone,
+	"bcp47_language_tag": constants.FieldInvalidLocale,
+	"timezone":           constants.FieldInvalidTimezone,
+}
+
+// Create a single validator instance to be reused, with a translator per locale
+var validate, translators = newValidator()
+
+// newValidator creates the validator and registers its translations for every locale
+func newValidator() (*validator.Validate, map[string]ut.Translator) {
+	v := validator.New()
+
+	// Report fields by their JSON names, as sent by the client
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			return strings.ToLower(field.Name)
+		}
+		return name
+	})
+
+	fallback := validatorLocales[i18n.DefaultLocale]
+	uni := ut.New(fallback.translator)
+
+	translators := make(map[string]ut.Translator)
+	for _, locale := range i18n.Default().Locales() {
+		vl, ok := validatorLocales[locale]
+		if !ok {
+			vl = fallback
+		}
+		uni.AddTranslator(vl.translator, true)
+		trans, _ := uni.GetTranslator(vl.translator.Locale())
+
+		if err := vl.register(v, trans); err != nil {
+			panic(fmt.Sprintf("failed to register validator translations for %s: %v", locale, err))
+		}
+		for tag, message := range fieldMessages {
+			if err := v.RegisterTranslation(tag, trans, noopRegister, catalogTranslation(locale, message)); err != nil {
+				panic(fmt.Sprintf("failed to register validator translations for %s: %v", locale, err))
+			}
+		}
+		translators[locale] = trans
+	}
+
+	return v, translators
+}
+
+// noopRegister is used for rules whose text comes from the message catalogs rather than the translator
+func noopRegister(ut.Translator) error {
+	return nil
+}
 
-// ValidateRequest handles validation of request models
-// It returns a formatted error response if validation fails
-func ValidateRequest(ctx fiber.Ctx, model interface{}) *fiber.Error {
+// catalogTranslation translates a rule's message through the catalog of the given locale
+func catalogTranslation(locale, message string) validator.TranslationFunc {
+	return func(_ ut.Translator, fe validator.FieldError) string {
+		if strings.Count(message, "%s") == 1 {
+			return i18n.Translate(locale, message, fe.Field())
+		}
+		return i18n.Translate(locale, message, fe.Field(), fe.Param())
+	}
+}
+
+// ValidateRequest handles validation of request models.
+// It returns an *appErrors.ErrInvalidRequest if the body can't be parsed, or an
+// *appErrors.ErrValidation listing every field that failed validation, with
+// messages in the request's locale.
+func ValidateRequest(ctx fiber.Ctx, model interface{}) error {
 	// Parse request body into model
 	if err := ctx.Bind().Body(model); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest,
-			fmt.Sprintf("%s: %s", constants.InvalidRequestFormat, err.Error()))
+		return &appErrors.ErrInvalidRequest{Field: "body", Message: err.Error()}
 	}
 
 	// Validate the model against its validation tags
 	if err := validate.Struct(model); err != nil {
-		// Format validation errors in a user-friendly way
-		var errorMessages []string
+		locale := common.Locale(ctx)
+		trans := translators[locale]
+
+		validationErr := &appErrors.ErrValidation{}
 		for _, err := range err.(validator.ValidationErrors) {
-			field := strings.ToLower(err.Field())
-			switch err.Tag() {
-			case "required":
-				errorMessages = append(errorMessages, fmt.Sprintf(constants.FieldRequired, field))
-			case "email":
-				errorMessages = append(errorMessages, fmt.Sprintf(constants.FieldInvalidEmail, field))
-			case "min":
-				errorMessages = append(errorMessages, fmt.Sprintf(constants.FieldMinLength, field, err.Param()))
-			case "max":
-				errorMessages = append(errorMessages, fmt.Sprintf(constants.FieldMaxLength, field, err.Param()))
-			case "gte":
-				errorMessages = append(errorMessages, fmt.Sprintf(constants.FieldMinValue, field, err.Param()))
-			case "lte":
-				errorMessages = append(errorMessages, fmt.Sprintf(constants.FieldMaxValue, field, err.Param()))
-			default:
-				errorMessages = append(errorMessages, fmt.Sprintf(constants.FieldGenericValidation, field, err.Tag()))
+			message := err.Translate(trans)
+			if trans == nil || message == err.Error() {
+				// No translation for this rule
+				message = i18n.Translate(locale, constants.FieldGenericValidation, err.Field(), err.Tag())
 			}
+
+			validationErr.Fields = append(validationErr.Fields, appErrors.FieldViolation{
+				Field:   err.Field(),
+				Code:    err.Tag(),
+				Message: message,
+			})
 		}
 
-		return fiber.NewError(
-			fiber.StatusBadRequest,
-			fmt.Sprintf(constants.ValidationError, strings.Join(errorMessages, "; ")),
-		)
+		return validationErr
 	}
 
 	return nil
@@ -0,0 +1,14 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// UserAgent returns the client's User-Agent header. Fiber reuses the memory
+// of request headers once the handler returns, so the value is copied to be
+// safe to store.
+func UserAgent(c fiber.Ctx) string {
+	return strings.Clone(c.Get(fiber.HeaderUserAgent))
+}
@@ -0,0 +1,21 @@
+package common
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/i18n"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Locale returns the locale negotiated for the request by the Localization
+// middleware, or the default locale if it did not run.
+func Locale(c fiber.Ctx) string {
+	if locale, ok := c.Locals("locale").(string); ok {
+		return locale
+	}
+	return i18n.DefaultLocale
+}
+
+// Localize translates a message into the request's locale, formatted with args if any.
+func Localize(c fiber.Ctx, message string, args ...any) string {
+	return i18n.Translate(Locale(c), message, args...)
+}
@@ -0,0 +1,22 @@
+package common
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// SetPrincipal stores the caller of the request in the request context,
+// where handlers and services find it.
+func SetPrincipal(c fiber.Ctx, principal *service.Principal) {
+	c.SetContext(service.WithPrincipal(c.Context(), principal))
+}
+
+// Principal returns the caller of the request stored by the authentication
+// middleware, or an anonymous principal with no rights if none ran.
+func Principal(c fiber.Ctx) *service.Principal {
+	if principal, ok := service.PrincipalFromContext(c.Context()); ok {
+		return principal
+	}
+	return &service.Principal{}
+}
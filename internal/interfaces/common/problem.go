@@ -0,0 +1,121 @@
+package common
+
+import (
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// MIMEApplicationProblemJSON is the media type of RFC 7807 problem details
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// problemTypeBase prefixes error codes to build the problem type URI
+const problemTypeBase = "/problems/"
+
+// Problem is an RFC 7807 problem details object, extended with a stable
+// machine-readable code and the list of fields that failed validation.
+type Problem struct {
+	Type     string                     `json:"type"`               // URI reference identifying the problem type
+	Title    string                     `json:"title"`              // Short, human-readable summary
+	Status   int                        `json:"status"`             // HTTP status code
+	Detail   string                     `json:"detail,omitempty"`   // Explanation specific to this occurrence
+	Instance string                     `json:"instance,omitempty"` // Request path where the problem occurred
+	Code     string                     `json:"code"`               // Stable error code, see pkg/errors
+	Errors   []appErrors.FieldViolation `json:"errors,omitempty"`   // Per-field validation failures
+
+	detailArgs []any // Arguments formatting Detail once it is translated
+}
+
+// NewProblem creates a problem for the given status and error code.
+func NewProblem(status int, code, title, detail string) *Problem {
+	return &Problem{
+		Type:   problemTypeBase + code,
+		Title:  title,
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// ProblemFor builds the problem registered for err in the pkg/errors registry.
+// The operation message is used as title when the mapping leaves it empty,
+// and fields that failed validation are listed in the problem's errors.
+// It reports false if no mapping matches err.
+func ProblemFor(err error, operationMsg string) (*Problem, bool) {
+	mapping, ok := appErrors.Lookup(err)
+	if !ok {
+		return nil, false
+	}
+
+	title := mapping.Title
+	if title == "" {
+		title = operationMsg
+	}
+	detail := mapping.Detail
+	if detail == "" {
+		detail = err.Error()
+	}
+
+	problem := NewProblem(mapping.Status, mapping.Code, title, detail)
+
+	var validationErr *appErrors.ErrValidation
+	if errors.As(err, &validationErr) {
+		problem.Errors = validationErr.Fields
+		if mapping.Detail == "" {
+			problem.Detail = constants.ValidationError
+			problem.detailArgs = []any{validationErr.Messages()}
+		}
+	}
+
+	return problem, true
+}
+
+// SendError writes an error response for the given status and error code.
+// See SendProblem for how the response format is chosen.
+func SendError(c fiber.Ctx, status int, code, title, detail string) error {
+	return SendProblem(c, NewProblem(status, code, title, detail))
+}
+
+// SendProblem writes a problem as application/problem+json, unless the client's
+// Accept header prefers application/json, in which case the legacy ResponseModel
+// envelope is written instead so existing clients keep working.
+// The title and detail are translated into the request's locale when a
+// translation exists; error texts without one are sent as they are.
+func SendProblem(c fiber.Ctx, problem *Problem) error {
+	c.Status(problem.Status)
+
+	problem.Title = Localize(c, problem.Title)
+	problem.Detail = Localize(c, problem.Detail, problem.detailArgs...)
+
+	if c.Accepts(MIMEApplicationProblemJSON, fiber.MIMEApplicationJSON) == fiber.MIMEApplicationJSON {
+		return c.JSON(NewErrorResponse(problem.Title, problem.Detail))
+	}
+
+	if problem.Instance == "" {
+		problem.Instance = c.Path()
+	}
+	return c.JSON(problem, MIMEApplicationProblemJSON)
+}
+
+// CodeForStatus returns the generic error code for an HTTP status,
+// for errors that carry no more specific code, such as those raised by the framework.
+func CodeForStatus(status int) string {
+	switch {
+	case status == fiber.StatusUnauthorized:
+		return appErrors.CodeUnauthorized
+	case status == fiber.StatusForbidden:
+		return appErrors.CodeForbidden
+	case status == fiber.StatusNotFound:
+		return appErrors.CodeNotFound
+	case status == fiber.StatusTooManyRequests:
+		return appErrors.CodeRateLimited
+	case status == fiber.StatusRequestTimeout, status == fiber.StatusGatewayTimeout:
+		return appErrors.CodeRequestTimeout
+	case status >= fiber.StatusInternalServerError:
+		return appErrors.CodeInternal
+	default:
+		return appErrors.CodeInvalidRequest
+	}
+}
@@ -0,0 +1,110 @@
+package common
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestSendProblemNegotiation(t *testing.T) {
+	tests := []struct {
+		name            string
+		accept          string
+		wantContentType string
+		wantProblem     bool
+	}{
+		{
+			name:            "No Accept Header",
+			wantContentType: MIMEApplicationProblemJSON,
+			wantProblem:     true,
+		},
+		{
+			name:            "Accepts Problem JSON",
+			accept:          MIMEApplicationProblemJSON,
+			wantContentType: MIMEApplicationProblemJSON,
+			wantProblem:     true,
+		},
+		{
+			name:            "Accepts Anything",
+			accept:          "*/*",
+			wantContentType: MIMEApplicationProblemJSON,
+			wantProblem:     true,
+		},
+		{
+			name:            "Prefers Problem JSON",
+			accept:          "application/problem+json, application/json;q=0.5",
+			wantContentType: MIMEApplicationProblemJSON,
+			wantProblem:     true,
+		},
+		{
+			name:            "Accepts Only JSON",
+			accept:          fiber.MIMEApplicationJSON,
+			wantContentType: fiber.MIMEApplicationJSON,
+		},
+		{
+			name:            "Prefers JSON",
+			accept:          "application/json, application/problem+json;q=0.5",
+			wantContentType: fiber.MIMEApplicationJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/users/1", func(c fiber.Ctx) error {
+				return SendError(c, fiber.StatusNotFound, "user_not_found", "Cannot get user", "user 1 not found")
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/users/1", nil)
+			if tt.accept != "" {
+				req.Header.Set(fiber.HeaderAccept, tt.accept)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() unexpected error = %v", err)
+			}
+
+			if resp.StatusCode != fiber.StatusNotFound {
+				t.Errorf("status = %v, want %v", resp.StatusCode, fiber.StatusNotFound)
+			}
+			if got := resp.Header.Get(fiber.HeaderContentType); !strings.HasPrefix(got, tt.wantContentType) {
+				t.Errorf("Content-Type = %q, want %q", got, tt.wantContentType)
+			}
+
+			var body map[string]any
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("decoding body: unexpected error = %v", err)
+			}
+
+			if tt.wantProblem {
+				want := map[string]any{
+					"type":     "/problems/user_not_found",
+					"title":    "Cannot get user",
+					"status":   float64(fiber.StatusNotFound),
+					"detail":   "user 1 not found",
+					"instance": "/users/1",
+					"code":     "user_not_found",
+				}
+				for key, value := range want {
+					if body[key] != value {
+						t.Errorf("problem %s = %v, want %v", key, body[key], value)
+					}
+				}
+				return
+			}
+
+			if body["success"] != false {
+				t.Errorf("envelope success = %v, want false", body["success"])
+			}
+			if want := "Cannot get user: user 1 not found"; body["message"] != want {
+				t.Errorf("envelope message = %v, want %q", body["message"], want)
+			}
+			if _, ok := body["code"]; ok {
+				t.Errorf("envelope has problem field code, want legacy envelope only")
+			}
+		})
+	}
+}
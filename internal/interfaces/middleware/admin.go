@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// AdminOnly middleware restricts access to administrators.
+// It must run after JWTProtected, which stores the caller with the admin flag.
+func AdminOnly() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if !common.Principal(c).Admin {
+			return common.SendError(c, fiber.StatusForbidden, appErrors.CodeForbidden,
+				constants.ForbiddenAction, constants.AccessDenied)
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// HeaderAPIKey is the request header carrying an API key
+const HeaderAPIKey = "X-API-Key"
+
+// APIKeyOrJWT middleware for routes that accept service-to-service callers.
+// Requests with an X-API-Key header are authenticated with the key; all others
+// are passed to jwtProtected, the JWTProtected middleware.
+// API key callers are stored as a service.Principal like users, named by the
+// key's prefix and holding its scopes, see RequireScopes. They are never
+// administrators and have no user ID, as no user is involved.
+func APIKeyOrJWT(apiKeyService *service.APIKeyService, jwtProtected fiber.Handler) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		rawKey := c.Get(HeaderAPIKey)
+		if rawKey == "" {
+			return jwtProtected(c)
+		}
+
+		key, err := apiKeyService.Authenticate(c.Context(), rawKey)
+		if err != nil {
+			if problem, ok := common.ProblemFor(err, constants.AuthenticationFailed); ok {
+				return common.SendProblem(c, problem)
+			}
+			return err
+		}
+
+		common.SetPrincipal(c, &service.Principal{
+			Subject:  key.Prefix(),
+			TenantID: key.TenantID(),
+			Username: key.Prefix(),
+			Scopes:   key.Scopes(),
+			APIKeyID: key.ID(),
+		})
+
+		return c.Next()
+	}
+}
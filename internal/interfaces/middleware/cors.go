@@ -5,28 +5,15 @@ import (
 	"github.com/gofiber/fiber/v3/middleware/cors"
 )
 
-// ConfigureDefaultCORS creates a default CORS middleware with sensible defaults.
-// This middleware allows cross-origin requests from the specified origins.
-func ConfigureDefaultCORS() fiber.Handler {
-	return cors.New(cors.Config{
-		AllowOrigins:     []string{"http://localhost:3000", "http://localhost:8080", "http://127.0.0.1:3000", "http://127.0.0.1:8080"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		AllowCredentials: true,
-		ExposeHeaders:    []string{"Content-Length", "Content-Type"},
-		MaxAge:           86400, // 24 hours
-	})
-}
-
 // ConfigureCORS creates a custom CORS middleware with the provided configuration.
 // This is useful for different environments (dev, prod, etc.)
 func ConfigureCORS(allowOrigins []string, allowCredentials bool) fiber.Handler {
 	return cors.New(cors.Config{
 		AllowOrigins:     allowOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", HeaderTenantID},
 		AllowCredentials: allowCredentials,
-		ExposeHeaders:    []string{"Content-Length", "Content-Type"},
+		ExposeHeaders:    []string{"Content-Length", "Content-Type", HeaderRateLimitLimit, HeaderRateLimitRemaining, HeaderRateLimitReset, fiber.HeaderRetryAfter},
 		MaxAge:           86400, // 24 hours
 	})
 }
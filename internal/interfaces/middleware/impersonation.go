@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// DenyImpersonation middleware restricts a route to users acting for themselves,
+// such as changing their password or MFA settings, rejecting administrators
+// who impersonate them. It must run after JWTProtected, which stores the caller.
+func DenyImpersonation() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if common.Principal(c).IsImpersonated() {
+			return common.SendError(c, fiber.StatusForbidden, appErrors.CodeImpersonationRestricted,
+				constants.ImpersonationRestricted, constants.ImpersonationRestrictedDetail)
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestDenyImpersonation(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  *service.Principal
+		wantStatus int
+	}{
+		{"User", &service.Principal{UserID: 2}, fiber.StatusOK},
+		{"Impersonated User", &service.Principal{UserID: 2, Actor: &service.Actor{Subject: "5", Username: "admin"}}, fiber.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(func(c fiber.Ctx) error {
+				common.SetPrincipal(c, tt.principal)
+				return c.Next()
+			})
+			app.Post("/", func(c fiber.Ctx) error {
+				return c.SendString("done")
+			}, DenyImpersonation())
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/", nil))
+			if err != nil {
+				t.Fatalf("app.Test() unexpected error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
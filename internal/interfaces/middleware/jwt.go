@@ -1,79 +1,93 @@
 package middleware
 
 import (
+	"errors"
 	"fmt"
 	"mcanvr/example-golang-api-with-fiber/internal/application/service"
 	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
 	"strings"
 
 	"github.com/gofiber/fiber/v3"
-	"github.com/golang-jwt/jwt/v4"
 )
 
-// JWTProtected middleware for routes that require authentication
-func JWTProtected(jwtService *service.JWTService) fiber.Handler {
+// JWTProtected middleware for routes that require authentication.
+// Tokens issued in another tenant than the request's, of users who have since
+// been suspended or deactivated, issued before the user's last password change,
+// or of a revoked session are rejected.
+// The caller is stored in the request context as a service.Principal, read
+// with common.Principal; tokens issued to OAuth clients act with their granted
+// scopes and never as administrators. Requests made with an impersonation
+// token are recorded in the audit log once handled.
+func JWTProtected(
+	jwtService *service.JWTService,
+	authService *service.AuthService,
+	sessionService *service.SessionService,
+	impersonationService *service.ImpersonationService,
+) fiber.Handler {
 	return func(c fiber.Ctx) error {
 		// Get auth header
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(common.NewErrorResponse(
-				constants.UnauthorizedAccess,
-				constants.MissingToken,
-			))
+			return common.SendError(c, fiber.StatusUnauthorized, appErrors.CodeUnauthorized,
+				constants.UnauthorizedAccess, constants.MissingToken)
 		}
 
 		// Extract the Bearer token
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			return c.Status(fiber.StatusUnauthorized).JSON(common.NewErrorResponse(
-				constants.UnauthorizedAccess,
-				constants.InvalidTokenFormat,
-			))
+			return common.SendError(c, fiber.StatusUnauthorized, appErrors.CodeUnauthorized,
+				constants.UnauthorizedAccess, constants.InvalidTokenFormat)
 		}
 
 		tokenString := parts[1]
 
 		// Validate the token
-		token, claims, err := jwtService.ValidateToken(tokenString)
-		if err != nil || !token.Valid {
-			return c.Status(fiber.StatusUnauthorized).JSON(common.NewErrorResponse(
-				constants.UnauthorizedAccess,
-				fmt.Sprintf(constants.InvalidOrExpiredToken, err.Error()),
-			))
+		claims, err := jwtService.ValidateToken(c.Context(), tokenString)
+		if err != nil {
+			return common.SendError(c, fiber.StatusUnauthorized, appErrors.CodeInvalidToken,
+				constants.UnauthorizedAccess, fmt.Sprintf(constants.InvalidOrExpiredToken, err.Error()))
 		}
 
-		// Store user information in locals for later use in the request lifecycle
-		c.Locals("user_id", claims["user_id"])
-		c.Locals("username", claims["username"])
-		c.Locals("admin", claims["admin"])
-
-		return c.Next()
-	}
-}
+		// Reject tokens issued in another tenant, see the Tenant middleware
+		if err := service.CheckTenant(c.Context(), claims.TenantID); err != nil {
+			if problem, ok := common.ProblemFor(err, constants.AuthenticationFailed); ok {
+				return common.SendProblem(c, problem)
+			}
+			return err
+		}
 
-// ExtractTokenClaims extracts claims from the JWT token in the context
-func ExtractTokenClaims(c fiber.Ctx) (jwt.MapClaims, error) {
-	// First try to get from locals where JWTProtected middleware stores the claims
-	if userId := c.Locals("user_id"); userId != nil {
-		claims := jwt.MapClaims{
-			"user_id":  c.Locals("user_id"),
-			"username": c.Locals("username"),
-			"admin":    c.Locals("admin"),
+		// Reject tokens of users who may no longer sign in, revoked by a password change or of a revoked session
+		if claims.ClientID == "" {
+			if err := authService.CheckToken(c.Context(), claims); err != nil {
+				if problem, ok := common.ProblemFor(err, constants.AuthenticationFailed); ok {
+					return common.SendProblem(c, problem)
+				}
+				return err
+			}
+		}
+		if claims.SessionID != 0 {
+			sessionService.Touch(c.Context(), claims.SessionID, c.IP(), common.UserAgent(c))
 		}
-		return claims, nil
-	}
 
-	// Fallback to token extraction if middleware didn't store claims
-	token, ok := c.Locals("jwt").(*jwt.Token)
-	if !ok {
-		return nil, fmt.Errorf(constants.TokenInvalid)
-	}
+		principal := claims.Principal()
+		common.SetPrincipal(c, principal)
 
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, fmt.Errorf(constants.TokenInvalid)
-	}
+		if !principal.IsImpersonated() {
+			return c.Next()
+		}
 
-	return claims, nil
+		err = c.Next()
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status = fiberErr.Code
+			}
+		}
+		impersonationService.RecordRequest(c.Context(), principal, c.Method(), c.Path(), status, c.IP())
+		return err
+	}
 }
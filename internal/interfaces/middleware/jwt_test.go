@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	domainService "mcanvr/example-golang-api-with-fiber/internal/domain/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/eventbus"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/hashing"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/secret"
+
+	"github.com/gofiber/fiber/v3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// newTestJWTService returns a JWT service signing tokens with a test key
+func newTestJWTService() *service.JWTService {
+	return service.NewJWTService(
+		secret.NewMemoryProvider(map[string]string{service.JWTSecretName: "test-signing-key-of-at-least-32-bytes"}),
+		inmemory.NewInMemoryRevokedTokenRepository(),
+		service.JWTPolicy{Issuer: "test-issuer", Audience: "test-audience", TTL: time.Hour},
+	)
+}
+
+func TestJWTProtectedSession(t *testing.T) {
+	tests := []struct {
+		name       string
+		revoke     bool
+		wantStatus int
+	}{
+		{"Active Session", false, fiber.StatusOK},
+		{"Revoked Session", true, fiber.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := model.WithTenant(context.Background(), model.DefaultTenantID)
+
+			userRepo := inmemory.NewInMemoryUserRepository()
+			if err := inmemory.InitializeWithSampleData(userRepo); err != nil {
+				t.Fatalf("InitializeWithSampleData() unexpected error = %v", err)
+			}
+			users := domainService.NewUserService(userRepo, eventbus.NewBus())
+			audit := service.NewAuditService(inmemory.NewInMemoryAuditRepository())
+			jwtService := newTestJWTService()
+			sessions := service.NewSessionService(inmemory.NewInMemorySessionRepository(), users, audit, time.Hour)
+			auth := service.NewAuthService(users, jwtService, nil, sessions, hashing.NewBcryptHasher(bcrypt.MinCost),
+				inmemory.NewInMemoryLoginAttemptRepository(), service.LoginProtection{}, audit)
+
+			// Jane, an active sample user, signs in
+			session, _, err := sessions.Create(ctx, model.SessionGrant{UserID: 2, Username: "jane@example.com", Scopes: model.Scopes}, "127.0.0.1", "test")
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+			token, err := jwtService.GenerateToken(ctx, session)
+			if err != nil {
+				t.Fatalf("GenerateToken() unexpected error = %v", err)
+			}
+			if tt.revoke {
+				if err := sessions.Revoke(ctx, 2, session.ID(), "jane@example.com", "127.0.0.1"); err != nil {
+					t.Fatalf("Revoke() unexpected error = %v", err)
+				}
+			}
+
+			app := fiber.New()
+			app.Use(func(c fiber.Ctx) error {
+				c.SetContext(model.WithTenant(c.Context(), model.DefaultTenantID))
+				return c.Next()
+			})
+			app.Get("/", func(c fiber.Ctx) error {
+				return c.SendString("done")
+			}, JWTProtected(jwtService, auth, sessions, nil))
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() unexpected error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/i18n"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Localization negotiates the response language from the Accept-Language header.
+// The chosen locale is stored in locals for the response helpers and in the
+// request context for services, and reported in the Content-Language header.
+// Unsupported languages fall back to English.
+func Localization(bundle *i18n.Bundle) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		locale := bundle.Match(c.Get(fiber.HeaderAcceptLanguage))
+
+		c.Locals("locale", locale)
+		c.SetContext(i18n.WithLocale(c.Context(), locale))
+		c.Set(fiber.HeaderContentLanguage, locale)
+		c.Vary(fiber.HeaderAcceptLanguage)
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// MFARequired middleware restricts access to tokens issued after multi-factor authentication.
+// It must run after JWTProtected, which stores the caller with the token's "amr" claim.
+func MFARequired() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if common.Principal(c).HasAMR(service.AMRMFA) {
+			return c.Next()
+		}
+
+		return common.SendError(c, fiber.StatusForbidden, appErrors.CodeMFARequired,
+			constants.MFARequired, constants.MFARequiredDetail)
+	}
+}
@@ -1,38 +1,126 @@
 package middleware
 
 import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
 	"time"
 
 	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/ratelimit"
 	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
 
 	"github.com/gofiber/fiber/v3"
-	"github.com/gofiber/fiber/v3/middleware/limiter"
 )
 
-// ConfigureRateLimiter creates a rate limiter middleware with the specified configuration.
-// It restricts the number of requests from a client based on IP address.
-func ConfigureRateLimiter(max int, duration time.Duration) fiber.Handler {
-	return limiter.New(limiter.Config{
-		Max:               max,      // Maximum number of requests
-		Expiration:        duration, // Request counter reset duration
-		LimiterMiddleware: limiter.SlidingWindow{},
-		KeyGenerator: func(c fiber.Ctx) string {
-			return c.IP() // IP based rate limiting
-		},
-		LimitReached: func(c fiber.Ctx) error {
-			logger.Warn(constants.RateLimitExceeded, c.IP())
-			return c.Status(fiber.StatusTooManyRequests).JSON(common.NewErrorResponse(
-				constants.TooManyRequests,
-				constants.RateLimitExceededUI,
-			))
-		},
-	})
+// Rate limit response headers (IETF draft "RateLimit header fields for HTTP")
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+)
+
+// RateLimitPolicy describes how many requests a client may make within a window.
+// Each policy keeps its own counters, so a route can be covered by several policies.
+type RateLimitPolicy struct {
+	Name   string        // Policy name, used to namespace counters (e.g. "login")
+	Max    int           // Maximum number of requests per window
+	Window time.Duration // Length of the counting window
+	ByIP   bool          // Count requests by IP address only, for policies applied before authentication
+}
+
+// RateLimiter enforces named rate limit policies against a shared counter store.
+// Policies can be replaced while the server is running, e.g. on configuration reload.
+type RateLimiter struct {
+	store    ratelimit.Store
+	policies map[string]RateLimitPolicy
+	mu       sync.RWMutex
+}
+
+// NewRateLimiter creates a rate limiter with the given counter store and policies.
+func NewRateLimiter(store ratelimit.Store, policies ...RateLimitPolicy) *RateLimiter {
+	r := &RateLimiter{store: store}
+	r.SetPolicies(policies...)
+	return r
 }
 
-// ConfigureDefaultRateLimiter creates a rate limiter with sensible defaults:
-// 50 requests per minute per IP address
-func ConfigureDefaultRateLimiter() fiber.Handler {
-	return ConfigureRateLimiter(50, 1*time.Minute)
+// SetPolicies replaces the current policies.
+// Handlers created with Limit pick up the new values on their next request.
+func (r *RateLimiter) SetPolicies(policies ...RateLimitPolicy) {
+	byName := make(map[string]RateLimitPolicy, len(policies))
+	for _, policy := range policies {
+		byName[policy.Name] = policy
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.policies = byName
+}
+
+// policy returns the current policy with the given name
+func (r *RateLimiter) policy(name string) (RateLimitPolicy, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policy, ok := r.policies[name]
+	return policy, ok
+}
+
+// Limit creates a middleware enforcing the named policy.
+// Clients are identified by authenticated user ID, API key or OAuth client when present,
+// falling back to the client IP address. Policies counting by IP address always use it.
+// Unknown policies do not limit requests.
+func (r *RateLimiter) Limit(name string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		policy, ok := r.policy(name)
+		if !ok {
+			return c.Next()
+		}
+
+		key := policy.Name + ":" + rateLimitKey(c, policy.ByIP)
+
+		count, resetAt, err := r.store.Increment(c.Context(), key, policy.Window)
+		if err != nil {
+			// Fail open so an unavailable store does not take the API down
+			logger.Error(constants.RateLimitStoreFailed, err)
+			return c.Next()
+		}
+
+		resetIn := int(math.Ceil(time.Until(resetAt).Seconds()))
+		remaining := max(policy.Max-count, 0)
+
+		c.Set(HeaderRateLimitLimit, strconv.Itoa(policy.Max))
+		c.Set(HeaderRateLimitRemaining, strconv.Itoa(remaining))
+		c.Set(HeaderRateLimitReset, strconv.Itoa(resetIn))
+
+		if count > policy.Max {
+			logger.Warn(constants.RateLimitExceeded, key)
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(resetIn))
+			return common.SendError(c, fiber.StatusTooManyRequests, appErrors.CodeRateLimited,
+				constants.TooManyRequests, constants.RateLimitExceededUI)
+		}
+
+		return c.Next()
+	}
+}
+
+// rateLimitKey identifies the client a request is counted against
+func rateLimitKey(c fiber.Ctx, byIP bool) string {
+	if byIP {
+		return "ip:" + c.IP()
+	}
+
+	principal := common.Principal(c)
+	switch {
+	case principal.IsUser():
+		return fmt.Sprintf("user:%d", principal.UserID)
+	case principal.APIKeyID != 0:
+		return fmt.Sprintf("apikey:%d", principal.APIKeyID)
+	case principal.ClientID != "":
+		return "client:" + principal.ClientID
+	}
+	return "ip:" + c.IP()
 }
@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/ratelimit"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestRateLimit(t *testing.T) {
+	policy := RateLimitPolicy{Name: "test", Max: 2, Window: time.Minute}
+
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		// Simulate an authenticated principal when the test asks for one
+		if userID, err := strconv.Atoi(c.Get("X-Test-User")); err == nil {
+			common.SetPrincipal(c, &service.Principal{UserID: userID})
+		}
+		return c.Next()
+	})
+	limiter := NewRateLimiter(ratelimit.NewMemoryStore(), policy)
+	app.Use(limiter.Limit(policy.Name))
+	app.Get("/", func(c fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	request := func(userID string) (int, string, string) {
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		if userID != "" {
+			req.Header.Set("X-Test-User", userID)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test() unexpected error = %v", err)
+		}
+		return resp.StatusCode, resp.Header.Get(HeaderRateLimitRemaining), resp.Header.Get(fiber.HeaderRetryAfter)
+	}
+
+	t.Run("Within Limit", func(t *testing.T) {
+		for _, wantRemaining := range []string{"1", "0"} {
+			status, remaining, _ := request("1")
+			if status != fiber.StatusOK {
+				t.Errorf("status = %v, want %v", status, fiber.StatusOK)
+			}
+			if remaining != wantRemaining {
+				t.Errorf("%s = %v, want %v", HeaderRateLimitRemaining, remaining, wantRemaining)
+			}
+		}
+	})
+
+	t.Run("Limit Exceeded", func(t *testing.T) {
+		status, remaining, retryAfter := request("1")
+		if status != fiber.StatusTooManyRequests {
+			t.Errorf("status = %v, want %v", status, fiber.StatusTooManyRequests)
+		}
+		if remaining != "0" {
+			t.Errorf("%s = %v, want 0", HeaderRateLimitRemaining, remaining)
+		}
+		if retryAfter == "" {
+			t.Errorf("%s header is missing", fiber.HeaderRetryAfter)
+		}
+	})
+
+	t.Run("Separate Counters Per Principal", func(t *testing.T) {
+		if status, _, _ := request("2"); status != fiber.StatusOK {
+			t.Errorf("user 2 status = %v, want %v", status, fiber.StatusOK)
+		}
+		if status, _, _ := request(""); status != fiber.StatusOK {
+			t.Errorf("anonymous status = %v, want %v", status, fiber.StatusOK)
+		}
+	})
+
+	t.Run("Policy Updated Live", func(t *testing.T) {
+		limiter.SetPolicies(RateLimitPolicy{Name: policy.Name, Max: 10, Window: policy.Window})
+		if status, _, _ := request("1"); status != fiber.StatusOK {
+			t.Errorf("status after raising limit = %v, want %v", status, fiber.StatusOK)
+		}
+	})
+}
+
+func TestRateLimitByIP(t *testing.T) {
+	policy := RateLimitPolicy{Name: "global", Max: 1, Window: time.Minute, ByIP: true}
+
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		userID, _ := strconv.Atoi(c.Get("X-Test-User"))
+		common.SetPrincipal(c, &service.Principal{UserID: userID})
+		return c.Next()
+	})
+	app.Use(NewRateLimiter(ratelimit.NewMemoryStore(), policy).Limit(policy.Name))
+	app.Get("/", func(c fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	// Different users from the same address share one counter
+	for userID, wantStatus := range []int{fiber.StatusOK, fiber.StatusTooManyRequests} {
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		req.Header.Set("X-Test-User", strconv.Itoa(userID+1))
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test() unexpected error = %v", err)
+		}
+		if resp.StatusCode != wantStatus {
+			t.Errorf("user %d status = %v, want %v", userID+1, resp.StatusCode, wantStatus)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
 	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -25,11 +26,8 @@ func Recover() fiber.Handler {
 				logger.Error(constants.PanicRecovered, err)
 
 				// Return user-friendly error response
-				c.Status(fiber.StatusInternalServerError)
-				_ = c.JSON(common.NewErrorResponse(
-					constants.InternalServerError,
-					constants.UserFriendlyServerError,
-				))
+				_ = common.SendError(c, fiber.StatusInternalServerError, appErrors.CodeInternal,
+					constants.InternalServerError, constants.UserFriendlyServerError)
 			}
 		}()
 
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// RequireScopes middleware restricts a route to callers granted every one of scopes.
+// It must run after JWTProtected or APIKeyOrJWT, which store the caller with
+// the scopes granted to the token or API key. Other callers are rejected with
+// 403 insufficient_scope and a WWW-Authenticate header naming the scopes
+// required, as described in RFC 6750 section 3.1.
+func RequireScopes(scopes ...string) fiber.Handler {
+	challenge := fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, strings.Join(scopes, " "))
+
+	return func(c fiber.Ctx) error {
+		principal := common.Principal(c)
+
+		for _, scope := range scopes {
+			if !principal.HasScope(scope) {
+				c.Set(fiber.HeaderWWWAuthenticate, challenge)
+				return common.SendError(c, fiber.StatusForbidden, appErrors.CodeInsufficientScope,
+					constants.InsufficientScope, constants.InsufficientScopeDetail)
+			}
+		}
+
+		return c.Next()
+	}
+}
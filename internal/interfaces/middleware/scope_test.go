@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestRequireScopes(t *testing.T) {
+	tests := []struct {
+		name       string
+		granted    []string
+		wantStatus int
+	}{
+		{"All Scopes Granted", []string{"users:read", "users:write"}, fiber.StatusOK},
+		{"Scope Missing", []string{"users:read"}, fiber.StatusForbidden},
+		{"No Scopes", []string{}, fiber.StatusForbidden},
+		{"Unauthenticated", nil, fiber.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(func(c fiber.Ctx) error {
+				if tt.granted != nil {
+					common.SetPrincipal(c, &service.Principal{Scopes: tt.granted})
+				}
+				return c.Next()
+			})
+			app.Post("/", func(c fiber.Ctx) error {
+				return c.SendString("done")
+			}, RequireScopes("users:read", "users:write"))
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/", nil))
+			if err != nil {
+				t.Fatalf("app.Test() unexpected error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+
+			wantChallenge := ""
+			if tt.wantStatus == fiber.StatusForbidden {
+				wantChallenge = `Bearer error="insufficient_scope", scope="users:read users:write"`
+			}
+			if got := resp.Header.Get(fiber.HeaderWWWAuthenticate); got != wantChallenge {
+				t.Errorf("WWW-Authenticate = %q, want %q", got, wantChallenge)
+			}
+		})
+	}
+}
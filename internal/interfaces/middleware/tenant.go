@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	"net"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// HeaderTenantID is the request header naming the tenant of a request
+const HeaderTenantID = "X-Tenant-ID"
+
+// Tenant resolves the tenant a request is made for and scopes the request
+// context to it, see model.WithTenant. The tenant is named by, in order:
+//   - the subdomain of baseDomain the request was sent to, if baseDomain is set
+//   - the X-Tenant-ID header
+//   - the "tid" claim of the bearer token
+//
+// Requests naming none are made for the default tenant. A bearer token issued
+// in another tenant than the one the request names is rejected with 403, and
+// unknown tenants with 404. The token itself is verified by JWTProtected.
+func Tenant(tenantService *service.TenantService, baseDomain string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		tenantID := subdomainTenant(c.Hostname(), baseDomain)
+		if tenantID == "" {
+			tenantID = strings.ToLower(c.Get(HeaderTenantID))
+		}
+
+		if tokenTenant := service.TokenTenant(bearerToken(c)); tokenTenant != "" {
+			if tenantID != "" && tenantID != tokenTenant {
+				return sendTenantProblem(c, service.ErrTenantMismatch)
+			}
+			tenantID = tokenTenant
+		}
+
+		if tenantID == "" {
+			tenantID = model.DefaultTenantID
+		}
+
+		tenant, err := tenantService.Get(c.Context(), tenantID)
+		if err != nil {
+			return sendTenantProblem(c, err)
+		}
+
+		c.SetContext(model.WithTenant(c.Context(), tenant.ID()))
+		return c.Next()
+	}
+}
+
+// sendTenantProblem responds with the problem registered for a tenant error
+func sendTenantProblem(c fiber.Ctx, err error) error {
+	if problem, ok := common.ProblemFor(err, constants.TenantNotFound); ok {
+		return common.SendProblem(c, problem)
+	}
+	return err
+}
+
+// subdomainTenant returns the tenant named by the subdomain of baseDomain in
+// host, or an empty string if host is not a direct subdomain of baseDomain
+func subdomainTenant(host, baseDomain string) string {
+	if baseDomain == "" {
+		return ""
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	label, ok := strings.CutSuffix(strings.ToLower(host), "."+strings.ToLower(baseDomain))
+	if !ok || label == "" || strings.Contains(label, ".") {
+		return ""
+	}
+	return label
+}
+
+// bearerToken returns the token of a "Bearer" Authorization header, or an empty string
+func bearerToken(c fiber.Ctx) string {
+	token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	if !ok {
+		return ""
+	}
+	return token
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func TestTenant(t *testing.T) {
+	tenantRepo := inmemory.NewInMemoryTenantRepository()
+	if err := inmemory.InitializeWithSampleTenants(tenantRepo); err != nil {
+		t.Fatalf("InitializeWithSampleTenants() unexpected error = %v", err)
+	}
+
+	acmeToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, service.Claims{TenantID: "acme"}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatalf("SignedString() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		host       string
+		header     string
+		token      string
+		wantStatus int
+		wantTenant string
+	}{
+		{"Default", "example.com", "", "", fiber.StatusOK, model.DefaultTenantID},
+		{"Subdomain", "acme.example.com", "", "", fiber.StatusOK, "acme"},
+		{"Subdomain With Port", "acme.example.com:8080", "", "", fiber.StatusOK, "acme"},
+		{"Header", "localhost", "ACME", "", fiber.StatusOK, "acme"},
+		{"Token", "localhost", "", acmeToken, fiber.StatusOK, "acme"},
+		{"Token Matching Header", "localhost", "acme", acmeToken, fiber.StatusOK, "acme"},
+		{"Token Of Another Tenant", "localhost", "default", acmeToken, fiber.StatusForbidden, ""},
+		{"Unknown Tenant", "globex.example.com", "", "", fiber.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/", func(c fiber.Ctx) error {
+				tenantID, err := model.TenantFromContext(c.Context())
+				if err != nil {
+					return err
+				}
+				return c.SendString(tenantID)
+			}, Tenant(service.NewTenantService(tenantRepo), "example.com"))
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			req.Host = tt.host
+			if tt.header != "" {
+				req.Header.Set(HeaderTenantID, tt.header)
+			}
+			if tt.token != "" {
+				req.Header.Set(fiber.HeaderAuthorization, "Bearer "+tt.token)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() unexpected error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantTenant == "" {
+				return
+			}
+
+			body := make([]byte, 64)
+			n, _ := resp.Body.Read(body)
+			if got := string(body[:n]); got != tt.wantTenant {
+				t.Errorf("tenant = %q, want %q", got, tt.wantTenant)
+			}
+		})
+	}
+}
+
+func TestSubdomainTenant(t *testing.T) {
+	tests := []struct {
+		host, baseDomain, want string
+	}{
+		{"acme.example.com", "example.com", "acme"},
+		{"ACME.Example.com", "example.com", "acme"},
+		{"example.com", "example.com", ""},
+		{"eu.acme.example.com", "example.com", ""},
+		{"acme.example.org", "example.com", ""},
+		{"acme.example.com", "", ""},
+	}
+
+	for _, tt := range tests {
+		if got := subdomainTenant(tt.host, tt.baseDomain); got != tt.want {
+			t.Errorf("subdomainTenant(%q, %q) = %q, want %q", tt.host, tt.baseDomain, got, tt.want)
+		}
+	}
+}
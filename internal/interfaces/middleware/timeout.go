@@ -2,58 +2,78 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/logger"
 	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
 	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
 
 	"github.com/gofiber/fiber/v3"
 )
 
-// RequestTimeout middleware adds a timeout to every request context
-// If the request takes longer than the specified duration, it will be canceled
+// Locals keys used to coordinate nested timeout middleware
+const (
+	timeoutParentKey = "timeout_parent_context"
+	timeoutOwnerKey  = "timeout_owner"
+)
+
+// timeoutOwner identifies the timeout middleware instance currently in charge of a request.
+// It must not be zero-sized, otherwise distinct instances could share an address.
+type timeoutOwner struct {
+	timeout time.Duration
+}
+
+// RequestTimeout middleware attaches a deadline to the request context.
+// The handler chain runs on the request goroutine; handlers, services and
+// repositories are expected to honor ctx cancellation and return early.
+// When the deadline is exceeded a 504 Gateway Timeout response is written,
+// unless the handler already completed its response before returning.
+//
+// The middleware can be applied again on a route or group to override the
+// global timeout. The innermost timeout always wins, whether it is shorter
+// or longer than the one applied globally.
 func RequestTimeout(timeout time.Duration) fiber.Handler {
 	return func(c fiber.Ctx) error {
-		// Create a new context with timeout
-		ctx, cancel := context.WithTimeout(c.Context(), timeout)
+		// Remember the context that existed before any timeout was applied
+		parent, ok := c.Locals(timeoutParentKey).(context.Context)
+		if !ok {
+			parent = c.Context()
+			c.Locals(timeoutParentKey, parent)
+		}
+
+		// Keep the values of the current context, such as the principal and tenant
+		// set since an outer timeout, but not its deadline, so a route-level override
+		// is not capped by the global one. The request is still canceled with parent.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Context()), timeout)
 		defer cancel()
+		stop := context.AfterFunc(parent, cancel)
+		defer stop()
 
-		// Replace the original context with the timeout context
+		owner := &timeoutOwner{timeout: timeout}
+		c.Locals(timeoutOwnerKey, owner)
 		c.SetContext(ctx)
 
-		// Create a channel to communicate when the request is complete
-		done := make(chan error, 1)
-
-		// Execute the request in a goroutine
-		go func() {
-			done <- c.Next()
-		}()
+		err := c.Next()
 
-		// Wait for either completion or timeout
-		select {
-		case err := <-done:
-			// Request completed within the timeout
+		// A nested timeout middleware took over and already handled the outcome
+		if c.Locals(timeoutOwnerKey) != owner {
 			return err
-		case <-ctx.Done():
-			// Context timed out
-			if ctx.Err() == context.DeadlineExceeded {
-				logger.Warn(constants.RequestTimeoutLog, c.Method(), c.Path())
-				return c.Status(fiber.StatusRequestTimeout).JSON(common.NewErrorResponse(
-					constants.RequestTimeout,
-					constants.RequestTimeoutMessageUI,
-				))
-			}
-			// Context was canceled for another reason
-			return c.Status(fiber.StatusInternalServerError).JSON(common.NewErrorResponse(
-				constants.RequestCanceled,
-				constants.RequestCanceledUI,
-			))
 		}
+
+		timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded) && !responseWritten(c)
+		if errors.Is(err, context.DeadlineExceeded) || timedOut {
+			logger.Warn(constants.RequestTimeoutLog, c.Method(), c.Path())
+			return common.SendError(c, fiber.StatusGatewayTimeout, appErrors.CodeRequestTimeout,
+				constants.RequestTimeout, constants.RequestTimeoutMessageUI)
+		}
+
+		return err
 	}
 }
 
-// DefaultRequestTimeout returns a timeout middleware with a sensible default timeout (10 seconds)
-func DefaultRequestTimeout() fiber.Handler {
-	return RequestTimeout(10 * time.Second)
+// responseWritten reports whether the handler chain already set a status or body
+func responseWritten(c fiber.Ctx) bool {
+	return c.Response().StatusCode() != fiber.StatusOK || len(c.Response().Body()) > 0
 }
@@ -0,0 +1,215 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"mcanvr/example-golang-api-with-fiber/internal/application/service"
+	"mcanvr/example-golang-api-with-fiber/internal/domain/model"
+	"mcanvr/example-golang-api-with-fiber/internal/infrastructure/persistence/inmemory"
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// slowHandler waits for the given delay or until the request context is done
+func slowHandler(delay time.Duration) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		select {
+		case <-time.After(delay):
+			return c.SendString("done")
+		case <-c.Context().Done():
+			return c.Context().Err()
+		}
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		global     time.Duration
+		route      time.Duration
+		delay      time.Duration
+		wantStatus int
+	}{
+		{
+			name:       "Completes Within Timeout",
+			global:     200 * time.Millisecond,
+			delay:      10 * time.Millisecond,
+			wantStatus: fiber.StatusOK,
+		},
+		{
+			name:       "Exceeds Timeout",
+			global:     20 * time.Millisecond,
+			delay:      time.Second,
+			wantStatus: fiber.StatusGatewayTimeout,
+		},
+		{
+			name:       "Route Override Longer Than Global",
+			global:     20 * time.Millisecond,
+			route:      500 * time.Millisecond,
+			delay:      50 * time.Millisecond,
+			wantStatus: fiber.StatusOK,
+		},
+		{
+			name:       "Route Override Shorter Than Global",
+			global:     time.Second,
+			route:      20 * time.Millisecond,
+			delay:      500 * time.Millisecond,
+			wantStatus: fiber.StatusGatewayTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(RequestTimeout(tt.global))
+
+			var routeMiddleware []fiber.Handler
+			if tt.route > 0 {
+				routeMiddleware = append(routeMiddleware, RequestTimeout(tt.route))
+			}
+			app.Get("/", slowHandler(tt.delay), routeMiddleware...)
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil), fiber.TestConfig{Timeout: 0})
+			if err != nil {
+				t.Fatalf("app.Test() unexpected error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRequestTimeoutKeepsCompletedResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    fiber.Handler
+		wantStatus int
+	}{
+		{
+			name: "Body Written Before Deadline",
+			handler: func(c fiber.Ctx) error {
+				err := c.Status(fiber.StatusCreated).SendString("created")
+				<-c.Context().Done()
+				return err
+			},
+			wantStatus: fiber.StatusCreated,
+		},
+		{
+			name: "Status Written Before Deadline",
+			handler: func(c fiber.Ctx) error {
+				err := c.SendStatus(fiber.StatusNoContent)
+				<-c.Context().Done()
+				return err
+			},
+			wantStatus: fiber.StatusNoContent,
+		},
+		{
+			name: "Handler Reports Deadline After Writing",
+			handler: func(c fiber.Ctx) error {
+				_ = c.SendString("partial")
+				<-c.Context().Done()
+				return c.Context().Err()
+			},
+			wantStatus: fiber.StatusGatewayTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(RequestTimeout(20 * time.Millisecond))
+			app.Get("/", tt.handler)
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil), fiber.TestConfig{Timeout: 0})
+			if err != nil {
+				t.Fatalf("app.Test() unexpected error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRequestTimeoutOverrideKeepsRequestValues(t *testing.T) {
+	tenantRepo := inmemory.NewInMemoryTenantRepository()
+	if err := inmemory.InitializeWithSampleTenants(tenantRepo); err != nil {
+		t.Fatalf("InitializeWithSampleTenants() unexpected error = %v", err)
+	}
+	jwtService := newTestJWTService()
+
+	token, err := jwtService.GenerateClientToken(model.WithTenant(context.Background(), "acme"), "client_1", nil, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateClientToken() unexpected error = %v", err)
+	}
+
+	app := fiber.New()
+	app.Use(Tenant(service.NewTenantService(tenantRepo), "example.com"))
+	app.Use(RequestTimeout(20 * time.Millisecond))
+
+	// The route outlasts the global timeout and reads the values set after it was applied
+	app.Get("/", func(c fiber.Ctx) error {
+		time.Sleep(50 * time.Millisecond)
+		if err := c.Context().Err(); err != nil {
+			return err
+		}
+
+		tenantID, err := model.TenantFromContext(c.Context())
+		if err != nil {
+			return err
+		}
+		return c.SendString(tenantID + " " + common.Principal(c).Username)
+	}, JWTProtected(jwtService, nil, nil, nil), RequestTimeout(500*time.Millisecond))
+
+	req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	req.Host = "acme.example.com"
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req, fiber.TestConfig{Timeout: 0})
+	if err != nil {
+		t.Fatalf("app.Test() unexpected error = %v", err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("status = %v, want %v", resp.StatusCode, fiber.StatusOK)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if got, want := string(body), "acme client_1"; got != want {
+		t.Errorf("tenant and principal = %q, want %q", got, want)
+	}
+}
+
+func TestRequestTimeoutConcurrent(t *testing.T) {
+	app := fiber.New()
+	app.Use(RequestTimeout(20 * time.Millisecond))
+	app.Get("/fast", slowHandler(0))
+	app.Get("/slow", slowHandler(time.Second))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		path, want := "/fast", fiber.StatusOK
+		if i%2 == 0 {
+			path, want = "/slow", fiber.StatusGatewayTimeout
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil), fiber.TestConfig{Timeout: 0})
+			if err != nil {
+				t.Errorf("app.Test() unexpected error = %v", err)
+				return
+			}
+			if resp.StatusCode != want {
+				t.Errorf("%s status = %v, want %v", path, resp.StatusCode, want)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"mcanvr/example-golang-api-with-fiber/internal/interfaces/common"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	appErrors "mcanvr/example-golang-api-with-fiber/pkg/errors"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// UsersOnly middleware restricts a route about the caller's own account to
+// signed-in users, rejecting tokens issued to OAuth clients.
+// It must run after JWTProtected, which stores the caller.
+func UsersOnly() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if !common.Principal(c).IsUser() {
+			return common.SendError(c, fiber.StatusForbidden, appErrors.CodeForbidden,
+				constants.ForbiddenAction, constants.AccessDenied)
+		}
+
+		return c.Next()
+	}
+}
@@ -4,45 +4,201 @@ package constants
 // These provide a central place to manage all message texts.
 const (
 	// User operation success messages
-	UsersFetched = "Users fetched successfully"
-	UserFound    = "User found"
-	UserCreated  = "User created successfully"
-	UserUpdated  = "User updated successfully"
-	UserDeleted  = "User deleted successfully"
+	UsersFetched    = "Users fetched successfully"
+	UserFound       = "User found"
+	UserCreated     = "User created successfully"
+	UserUpdated     = "User updated successfully"
+	UserDeleted     = "User deleted successfully"
+	UserActivated   = "User activated successfully"
+	UserSuspended   = "User suspended successfully"
+	UserDeactivated = "User deactivated successfully"
+	UserReactivated = "User reactivated successfully"
 
 	// User operation error messages - lowercase for error messages
-	UserNotFound      = "user not found"
-	CannotGetUsers    = "failed to retrieve users"
-	CannotCreateUser  = "failed to create user"
-	CannotUpdateUser  = "failed to update user"
-	CannotDeleteUser  = "failed to delete user"
-	InvalidIDFormat   = "invalid ID format"
-	MissingIDParam    = "missing ID parameter"
-	EmailAlreadyInUse = "email address is already in use"
+	UserNotFound       = "user not found"
+	CannotGetUsers     = "failed to retrieve users"
+	CannotCreateUser   = "failed to create user"
+	CannotUpdateUser   = "failed to update user"
+	CannotDeleteUser   = "failed to delete user"
+	InvalidIDFormat    = "invalid ID format"
+	MissingIDParam     = "missing ID parameter"
+	EmailAlreadyInUse  = "email address is already in use"
+	CannotChangeStatus = "failed to change user status"
+
+	// Account of the signed-in user
+	AccountDeleted             = "Account deleted successfully"                           // For UI display
+	SignedInUserRequiredDetail = "Only signed-in users have an account to act on."        // For UI display
+	EmailChangeAdminOnlyDetail = "Only administrators can change a user's email address." // For UI display
+	OwnAccountOnlyDetail       = "Users can only change or delete their own account."     // For UI display
+	CannotDeleteAccount        = "failed to delete account"
 
 	// General API messages
-	InvalidRequestFormat = "Invalid request format"       // For UI display
-	EndpointNotFound     = "Endpoint not found"           // For UI display
-	InternalServerError  = "Internal server error"        // For UI display
-	UnauthorizedAccess   = "Unauthorized access"          // For UI display
-	ForbiddenAction      = "Forbidden action"             // For UI display
-	ResourceNotFound     = "Requested resource not found" // For UI display
-	GeneralError         = "Error"                        // For UI display
-	RequestTimeout       = "Request timed out"            // For UI display
-	TooManyRequests      = "Too many requests"            // For UI display
-	RequestCanceled      = "Request canceled"             // For UI display
+	InvalidRequestFormat    = "Invalid request format"        // For UI display
+	EndpointNotFound        = "Endpoint not found"            // For UI display
+	InternalServerError     = "Internal server error"         // For UI display
+	UnauthorizedAccess      = "Unauthorized access"           // For UI display
+	ForbiddenAction         = "Forbidden action"              // For UI display
+	ResourceNotFound        = "Requested resource not found"  // For UI display
+	GeneralError            = "Error"                         // For UI display
+	RequestTimeout          = "Request timed out"             // For UI display
+	TooManyRequests         = "Too many requests"             // For UI display
+	InvalidStatusTransition = "User status cannot be changed" // For UI display
 
 	// Rate limiter messages
-	RateLimitExceeded       = "Rate limit exceeded for IP: %s"               // For logs
+	RateLimitExceeded       = "Rate limit exceeded for %s"                   // For logs - policy and client key
+	RateLimitStoreFailed    = "Rate limit store unavailable: %v"             // For logs
 	RateLimitExceededUI     = "Rate limit exceeded. Please try again later." // For UI display
 	RequestTimeoutMessageUI = "Request timed out. Please try again later."   // For UI display
-	RequestCanceledUI       = "Request canceled."                            // For UI display
 	RequestTimeoutLog       = "Request timed out: %s %s"                     // For logs - method, path
 
 	// Authentication messages
-	LoginSuccess         = "Login successful"      // For UI display
-	LoginFailed          = "Login failed"          // For UI display
-	AuthenticationFailed = "Authentication failed" // For UI display
+	LoginSuccess          = "Login successful"      // For UI display
+	LoginFailed           = "Login failed"          // For UI display
+	AuthenticationFailed  = "Authentication failed" // For UI display
+	UnlockSuccess         = "Login lockout cleared" // For UI display
+	CannotUnlock          = "failed to clear login lockout"
+	AccountDisabled       = "Account is disabled"                                                     // For UI display
+	AccountDisabledDetail = "This account is suspended or deactivated and cannot be used to sign in." // For UI display
+
+	// Multi-factor authentication messages
+	MFACodeRequired       = "Enter the code from your authenticator app to complete the login"                                                        // For UI display
+	MFAEnrollmentStarted  = "Add the secret to your authenticator app, then confirm with a code from the app"                                         // For UI display
+	MFAEnabled            = "Multi-factor authentication enabled. Store the recovery codes in a safe place; they will not be shown again"             // For UI display
+	MFADisabled           = "Multi-factor authentication disabled"                                                                                    // For UI display
+	MFAAlreadyEnabled     = "Multi-factor authentication is already enabled"                                                                          // For UI display
+	MFANotEnabled         = "Multi-factor authentication is not enabled"                                                                              // For UI display
+	MFARequired           = "Multi-factor authentication required"                                                                                    // For UI display
+	MFARequiredDetail     = "Sign in with multi-factor authentication to perform this action."                                                        // For UI display
+	InvalidMFACode        = "Invalid authentication code"                                                                                             // For UI display
+	InvalidMFACodeDetail  = "The code is invalid or was already used. Enter the current code from your authenticator app or an unused recovery code." // For UI display
+	InvalidMFAToken       = "Login session expired"                                                                                                   // For UI display
+	InvalidMFATokenDetail = "The login is invalid or took too long to complete. Please sign in again."                                                // For UI display
+	CannotEnrollMFA       = "failed to start multi-factor authentication enrollment"
+	CannotConfirmMFA      = "failed to enable multi-factor authentication"
+	CannotDisableMFA      = "failed to disable multi-factor authentication"
+
+	// API key messages
+	APIKeysFetched       = "API keys fetched successfully"                                              // For UI display
+	APIKeyCreated        = "API key created. Store the key in a safe place; it will not be shown again" // For UI display
+	APIKeyRevoked        = "API key revoked"                                                            // For UI display
+	APIKeyAlreadyRevoked = "API key is already revoked"                                                 // For UI display
+	InvalidAPIKeyDetail  = "The API key is invalid, expired or revoked."                                // For UI display
+	CannotGetAPIKeys     = "failed to retrieve API keys"
+	CannotCreateAPIKey   = "failed to create API key"
+	CannotRevokeAPIKey   = "failed to revoke API key"
+
+	// OAuth client messages
+	OAuthClientsFetched     = "OAuth clients fetched successfully"                                                           // For UI display
+	OAuthClientCreated      = "OAuth client registered. Store the client secret in a safe place; it will not be shown again" // For UI display
+	OAuthClientDeleted      = "OAuth client deleted"                                                                         // For UI display
+	CannotGetOAuthClients   = "failed to retrieve OAuth clients"
+	CannotCreateOAuthClient = "failed to register OAuth client"
+	CannotDeleteOAuthClient = "failed to delete OAuth client"
+
+	// Scope messages
+	InsufficientScope       = "Insufficient scope"                                                          // For UI display
+	InsufficientScopeDetail = "The credentials used were not granted the scope this action requires."       // For UI display
+	ScopeNotGrantedDetail   = "A requested scope does not exist or is not allowed."                         // For UI display
+	ScopeNotHeldDetail      = "New credentials cannot be granted a scope the credentials used do not hold." // For UI display
+
+	// Impersonation messages
+	ImpersonationStarted          = "Impersonation token issued. Requests made with it are recorded in the audit log"        // For UI display
+	ImpersonationNotAllowed       = "User cannot be impersonated"                                                            // For UI display
+	ImpersonationNotAllowedDetail = "Administrators cannot impersonate themselves or other administrators."                  // For UI display
+	ImpersonationRestricted       = "Not allowed while impersonating"                                                        // For UI display
+	ImpersonationRestrictedDetail = "Only the user themselves can perform this action, not an administrator acting as them." // For UI display
+	CannotImpersonate             = "failed to impersonate user"
+
+	// Session messages
+	SessionsFetched           = "Sessions fetched successfully"                                        // For UI display
+	SessionRevoked            = "Session revoked. The device has been signed out"                      // For UI display
+	SessionRevokedDetail      = "The session of this token was signed out. Please sign in again."      // For UI display
+	InvalidRefreshTokenDetail = "The refresh token is invalid, was already used or its session ended." // For UI display
+	CannotGetSessions         = "failed to retrieve sessions"
+	CannotRevokeSession       = "failed to revoke session"
+	CannotRefreshToken        = "failed to refresh authentication token"
+
+	// Tenant messages
+	TenantNotFound       = "Unknown tenant"                                                              // For UI display
+	TenantNotFoundDetail = "The tenant named by the request does not exist."                             // For UI display
+	TenantMismatch       = "Wrong tenant"                                                                // For UI display
+	TenantMismatchDetail = "The credentials belong to another tenant than the one named by the request." // For UI display
+
+	// Organization and team messages
+	OrganizationsFetched     = "Organizations fetched successfully"                                                        // For UI display
+	OrganizationFound        = "Organization found"                                                                        // For UI display
+	OrganizationCreated      = "Organization created successfully"                                                         // For UI display
+	OrganizationUpdated      = "Organization updated successfully"                                                         // For UI display
+	OrganizationDeleted      = "Organization deleted successfully"                                                         // For UI display
+	TeamsFetched             = "Teams fetched successfully"                                                                // For UI display
+	TeamFound                = "Team found"                                                                                // For UI display
+	TeamCreated              = "Team created successfully"                                                                 // For UI display
+	TeamUpdated              = "Team updated successfully"                                                                 // For UI display
+	TeamDeleted              = "Team deleted successfully"                                                                 // For UI display
+	MembersFetched           = "Members fetched successfully"                                                              // For UI display
+	MemberRoleChanged        = "Member role changed successfully"                                                          // For UI display
+	MemberRemoved            = "Member removed successfully"                                                               // For UI display
+	RoleRequired             = "Insufficient role"                                                                         // For UI display
+	RoleRequiredDetail       = "Your role in the organization or team does not allow this action."                         // For UI display
+	AlreadyMember            = "Already a member"                                                                          // For UI display
+	AlreadyMemberDetail      = "The user is already a member of the organization or team."                                 // For UI display
+	LastOwner                = "Last owner cannot leave"                                                                   // For UI display
+	LastOwnerDetail          = "An organization or team must keep at least one owner. Make another member an owner first." // For UI display
+	CannotGetOrganizations   = "failed to retrieve organizations"
+	CannotCreateOrganization = "failed to create organization"
+	CannotUpdateOrganization = "failed to update organization"
+	CannotDeleteOrganization = "failed to delete organization"
+	CannotGetTeams           = "failed to retrieve teams"
+	CannotCreateTeam         = "failed to create team"
+	CannotUpdateTeam         = "failed to update team"
+	CannotDeleteTeam         = "failed to delete team"
+	CannotGetMembers         = "failed to retrieve members"
+	CannotChangeMemberRole   = "failed to change member role"
+	CannotRemoveMember       = "failed to remove member"
+
+	// Invitation messages
+	InvitationsFetched      = "Invitations fetched successfully"                                                                                // For UI display
+	InvitationSent          = "Invitation sent"                                                                                                 // For UI display
+	InvitationCanceled      = "Invitation canceled"                                                                                             // For UI display
+	InvitationAccepted      = "Invitation accepted. You are now a member of the team"                                                           // For UI display
+	InvitationDeclined      = "Invitation declined"                                                                                             // For UI display
+	InvalidInvitation       = "Invalid invitation"                                                                                              // For UI display
+	InvalidInvitationDetail = "The invitation is invalid, expired or was already answered, or it was sent to another email address than yours." // For UI display
+	CannotGetInvitations    = "failed to retrieve invitations"
+	CannotSendInvitation    = "failed to send invitation"
+	CannotCancelInvitation  = "failed to cancel invitation"
+	CannotAnswerInvitation  = "failed to answer invitation"
+	InvitationEmailSubject  = "You are invited to join %s"
+	InvitationEmailBody     = "Hello,\n\n%s invited you to join the team %s of %s as %s. Sign in with this email address, then open the link below to accept or decline the invitation:\n\n%s\n\nThe link expires at %s. If you were not expecting this invitation, you can ignore this email."
+
+	// OAuth error descriptions, sent untranslated as RFC 6749 restricts them to ASCII
+	InvalidClientDetail        = "Client authentication failed"
+	InvalidGrantDetail         = "The refresh token is invalid, expired or was issued to another client"
+	UnsupportedGrantTypeDetail = "The grant type must be client_credentials or refresh_token"
+
+	// Email verification messages
+	EmailVerified             = "Email address verified"                                                                   // For UI display
+	VerificationSent          = "If the address belongs to an unverified account, a verification email has been sent"      // For UI display
+	InvalidVerificationToken  = "Invalid verification link"                                                                // For UI display
+	InvalidVerificationDetail = "The verification link is invalid, expired or was already used. Please request a new one." // For UI display
+	CannotVerifyEmail         = "failed to verify email address"
+	CannotResendVerification  = "failed to resend verification email"
+	VerificationEmailSubject  = "Verify your email address"
+	VerificationEmailBody     = "Hello %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires at %s. If you did not create an account, you can ignore this email."
+
+	// Password reset messages
+	PasswordResetRequested    = "If the address belongs to an account, a password reset email has been sent"                 // For UI display
+	PasswordResetSuccess      = "Password has been reset"                                                                    // For UI display
+	InvalidResetToken         = "Invalid password reset link"                                                                // For UI display
+	InvalidResetDetail        = "The password reset link is invalid, expired or was already used. Please request a new one." // For UI display
+	CannotRequestReset        = "failed to request password reset"
+	CannotResetPassword       = "failed to reset password"
+	PasswordChanged           = "Password changed. Please sign in again with the new password" // For UI display
+	WrongPassword             = "Incorrect password"                                           // For UI display
+	WrongPasswordDetail       = "The current password is incorrect."                           // For UI display
+	CannotChangePassword      = "failed to change password"
+	PasswordResetEmailSubject = "Reset your password"
+	PasswordResetEmailBody    = "Hello %s,\n\nWe received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\nThe link expires at %s. If you did not request a reset, you can ignore this email; your password will not change."
 
 	// Error messages (lowercase for use with errors.New/fmt.Errorf)
 	InvalidCredentials    = "invalid username or password"
@@ -53,24 +209,57 @@ const (
 	MissingToken          = "authentication token not found"
 	InvalidTokenFormat    = "invalid authentication format, use 'Bearer TOKEN' format"
 	InvalidOrExpiredToken = "invalid or expired token: %s"
+	TokenRevoked          = "authentication token has been revoked"
 
 	// Validation messages - user facing, can be capitalized
 	ValidationError = "Validation error: %s" // For UI display
 
 	// Validation field errors - lowercase for consistent error formatting
-	FieldRequired          = "field '%s' is required"
-	FieldInvalidEmail      = "field '%s' must be a valid email address"
-	FieldMinLength         = "field '%s' must be at least %s characters long"
-	FieldMaxLength         = "field '%s' must be at most %s characters long"
-	FieldMinValue          = "field '%s' must be greater than or equal to %s"
-	FieldMaxValue          = "field '%s' must be less than or equal to %s"
-	FieldGenericValidation = "field '%s' failed validation: %s"
+	FieldRequired             = "field '%s' is required"
+	FieldInvalidEmail         = "field '%s' must be a valid email address"
+	FieldMinLength            = "field '%s' must be at least %s characters long"
+	FieldMaxLength            = "field '%s' must be at most %s characters long"
+	FieldMaxBytes             = "field '%s' must be at most %s bytes long; some characters take several bytes"
+	FieldMinValue             = "field '%s' must be greater than or equal to %s"
+	FieldMaxValue             = "field '%s' must be less than or equal to %s"
+	FieldInvalidPhone         = "field '%s' must be a phone number in E.164 format, e.g. +14155552671"
+	FieldInvalidLocale        = "field '%s' must be a valid BCP 47 language tag, e.g. en-US"
+	FieldInvalidTimezone      = "field '%s' must be an IANA time zone name, e.g. Europe/Istanbul"
+	FieldPasswordCharClasses  = "field '%s' must use at least %s of: lowercase letters, uppercase letters, digits and symbols"
+	FieldPasswordPersonalInfo = "field '%s' must not contain your name or email address"
+	FieldPasswordBreached     = "field '%s' appears in a list of breached passwords, please choose another one"
+	FieldGenericValidation    = "field '%s' failed validation: %s"
 
 	// Server messages - used in logs, can be capitalized
-	ServerStarting          = "Server starting on %s"
-	ServerStartFailed       = "Server failed to start: %v"
-	UnexpectedError         = "Unexpected error: %v"
-	UserFriendlyServerError = "An unexpected server error occurred. Please try again later." // For UI display
-	PanicRecovered          = "Panic recovered: %v"
-	SampleDataInitFailed    = "Failed to initialize sample users: %v"
+	ServerStarting            = "Server starting on %s"
+	ServerStartFailed         = "Server failed to start: %v"
+	UnexpectedError           = "Unexpected error: %v"
+	UserFriendlyServerError   = "An unexpected server error occurred. Please try again later."               // For UI display
+	TransactionFailedUI       = "Your transaction cannot be processed at this time. Please try again later." // For UI display
+	PanicRecovered            = "Panic recovered: %v"
+	SampleDataInitFailed      = "Failed to initialize sample users: %v"
+	ShutdownSignalReceived    = "Received signal %s, shutting down"
+	ServerShutdownFailed      = "Server failed to shut down gracefully: %v"
+	WorkersStopFailed         = "Background workers did not stop in time: %v"
+	RepositoryCloseFailed     = "Failed to close repository %T: %v"
+	ServerStopped             = "Server stopped"
+	WorkerStarted             = "Background worker started: %s"
+	WorkerStopped             = "Background worker stopped: %s"
+	LoginLockoutLog           = "Login locked out for %s until %s"
+	LoginAttemptSaveFailed    = "Failed to save login attempts for %s: %v"
+	LoginAttemptCleanupFailed = "Failed to remove expired login attempts: %v"
+	AuditEventLog             = "AUDIT action=%s actor=%s subject=%s ip=%s details=%v"
+	AuditRecordFailed         = "Failed to record audit event %s: %v"
+	EventHandlerFailed        = "Failed to handle event %s: %v"
+	APIKeyUseSaveFailed       = "Failed to record use of API key %s: %v"
+	SessionTouchFailed        = "Failed to record use of session %d: %v"
+	OAuthRequestFailed        = "OAuth request failed: %v"
+	RecoveryCodeUsed          = "Recovery code used by user %d, %d left"
+	BreachCheckFailed         = "Breached password check failed: %v"
+	MailerInitFailed          = "Failed to initialize mailer: %v"
+	BreachListMissing         = "Breached password list %s not found, skipping the breached password check"
+	MailLogged                = "MAIL to=%s subject=%q\n%s"
+	MailWritten               = "Mail to %s written to %s"
+	VerificationResendSkip    = "Verification email for user %d not resent: previous one sent at %s"
+	PasswordResetSendFailed   = "Failed to send password reset email to user %d: %v"
 )
@@ -1,6 +1,11 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	"strings"
+	"time"
+)
 
 // Custom error types for application-specific error handling.
 // These typed errors allow for more precise error handling and
@@ -19,6 +24,33 @@ func (e *ErrInvalidRequest) Error() string {
 	return fmt.Sprintf("invalid %s: %s", e.Field, e.Message)
 }
 
+// FieldViolation describes a single field that failed validation.
+type FieldViolation struct {
+	Field   string `json:"field"`   // Name of the field as sent by the client
+	Code    string `json:"code"`    // Validation rule that failed (e.g. "required", "email")
+	Message string `json:"message"` // Human-readable description of the failure
+}
+
+// ErrValidation reports every field of a request that failed validation.
+type ErrValidation struct {
+	Fields []FieldViolation // Failed fields, in declaration order
+}
+
+// Error implements the error interface for ErrValidation.
+// Returns the field messages joined into a single sentence.
+func (e *ErrValidation) Error() string {
+	return fmt.Sprintf(constants.ValidationError, e.Messages())
+}
+
+// Messages returns the field messages joined into a single sentence.
+func (e *ErrValidation) Messages() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
 // ErrNotFound indicates that a requested resource couldn't be located.
 // Used primarily for database lookup failures.
 type ErrNotFound struct {
@@ -31,3 +63,36 @@ type ErrNotFound struct {
 func (e *ErrNotFound) Error() string {
 	return fmt.Sprintf("%s with id %v not found", e.Resource, e.ID)
 }
+
+// Code returns the resource-specific error code, such as "user_not_found"
+// or "api_key_not_found" for the resource "API key".
+func (e *ErrNotFound) Code() string {
+	if e.Resource == "" {
+		return CodeNotFound
+	}
+	return strings.ReplaceAll(strings.ToLower(e.Resource), " ", "_") + "_not_found"
+}
+
+// ErrTooManyAttempts indicates that an operation is temporarily blocked
+// after repeated failures, either by a progressive delay or a lockout.
+type ErrTooManyAttempts struct {
+	RetryAfter time.Duration // How long until the operation may be retried
+	Locked     bool          // Whether the subject is locked out rather than just delayed
+}
+
+// Error implements the error interface for ErrTooManyAttempts.
+// Returns a message stating whether the subject is locked and when to retry.
+func (e *ErrTooManyAttempts) Error() string {
+	if e.Locked {
+		return fmt.Sprintf("temporarily locked after too many failed attempts, retry in %s", e.RetryAfter.Round(time.Second))
+	}
+	return fmt.Sprintf("too many failed attempts, retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+// Code distinguishes a lockout from a progressive delay.
+func (e *ErrTooManyAttempts) Code() string {
+	if e.Locked {
+		return CodeAccountLocked
+	}
+	return CodeTooManyAttempts
+}
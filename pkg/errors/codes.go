@@ -0,0 +1,45 @@
+package errors
+
+// Stable, machine-readable error codes returned in the "code" member of error responses.
+// Clients should branch on these rather than on messages, which may change or be translated.
+// Existing codes must never be renamed; add new ones instead.
+const (
+	CodeInvalidRequest          = "invalid_request"            // Malformed request, such as an unparsable body or ID
+	CodeValidationFailed        = "validation_failed"          // One or more fields failed validation, see "errors"
+	CodeUnauthorized            = "unauthorized"               // Authentication is missing or malformed
+	CodeInvalidToken            = "invalid_token"              // The access token is invalid or expired
+	CodeInvalidCredentials      = "invalid_credentials"        // Username or password is wrong
+	CodeForbidden               = "forbidden"                  // Authenticated but not allowed to perform the action
+	CodeNotFound                = "not_found"                  // The endpoint or resource does not exist
+	CodeUserNotFound            = "user_not_found"             // The user does not exist
+	CodeEmailInUse              = "email_in_use"               // The email address belongs to another user
+	CodeInvalidTransition       = "invalid_status_transition"  // The user's status does not allow the requested change
+	CodeInvalidVerification     = "invalid_verification_token" // The email verification link is invalid, expired or used
+	CodeInvalidResetToken       = "invalid_reset_token"        // The password reset link is invalid, expired or used
+	CodeAccountDisabled         = "account_disabled"           // The account is suspended or deactivated
+	CodeInvalidMFACode          = "invalid_mfa_code"           // The authenticator or recovery code is wrong or was already used
+	CodeInvalidMFAToken         = "invalid_mfa_token"          // The MFA challenge of a login is invalid or expired, sign in again
+	CodeInvalidRefreshToken     = "invalid_refresh_token"      // The refresh token is invalid, was already used or its session ended, sign in again
+	CodeMFAAlreadyEnabled       = "mfa_already_enabled"        // The user already has multi-factor authentication enabled
+	CodeMFANotEnabled           = "mfa_not_enabled"            // The user has no multi-factor authentication to confirm or disable
+	CodeMFARequired             = "mfa_required"               // The action requires a token issued after multi-factor authentication
+	CodeInvalidAPIKey           = "invalid_api_key"            // The API key is malformed, unknown, expired or revoked
+	CodeAPIKeyRevoked           = "api_key_revoked"            // The API key was already revoked
+	CodeInvalidScope            = "invalid_scope"              // A requested scope does not exist or is not allowed
+	CodeInsufficientScope       = "insufficient_scope"         // The credentials were not granted a scope the action requires, see WWW-Authenticate
+	CodeInvalidClient           = "invalid_client"             // OAuth client authentication failed (RFC 6749)
+	CodeInvalidGrant            = "invalid_grant"              // The OAuth refresh token is invalid, expired or of another client
+	CodeUnsupportedGrant        = "unsupported_grant_type"     // The OAuth grant type is not supported
+	CodeImpersonationNotAllowed = "impersonation_not_allowed"  // Administrators and the caller themselves cannot be impersonated
+	CodeImpersonationRestricted = "impersonation_restricted"   // The action is not allowed while impersonating a user
+	CodeTenantNotFound          = "tenant_not_found"           // The tenant named by the subdomain or X-Tenant-ID does not exist
+	CodeTenantMismatch          = "tenant_mismatch"            // The credentials belong to another tenant than the one named by the request
+	CodeAlreadyMember           = "already_member"             // The user is already a member of the organization or team, or was invited to a team they belong to
+	CodeLastOwner               = "last_owner"                 // The last owner of an organization or team cannot leave or be demoted
+	CodeInvalidInvitation       = "invalid_invitation"         // The invitation is unknown, expired, already answered or addressed to another email
+	CodeRateLimited             = "rate_limited"               // Too many requests, see Retry-After
+	CodeTooManyAttempts         = "too_many_attempts"          // Failed attempts are being delayed, see Retry-After
+	CodeAccountLocked           = "account_locked"             // Locked out after too many failed attempts, see Retry-After
+	CodeRequestTimeout          = "request_timeout"            // The request took longer than its deadline
+	CodeInternal                = "internal_error"             // Unexpected server-side failure
+)
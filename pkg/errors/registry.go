@@ -0,0 +1,145 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"mcanvr/example-golang-api-with-fiber/pkg/constants"
+	"net/http"
+	"sync"
+)
+
+// Mapping describes how an error is reported to API clients.
+type Mapping struct {
+	Status int    // HTTP status code
+	Code   string // Stable error code, see codes.go
+	Title  string // Summary shown to clients; empty to use the failed operation's message
+	Detail string // Fixed explanation hiding the error text, e.g. for internal failures; empty to use the error message
+}
+
+// Coder is implemented by errors whose code depends on their values,
+// such as a not-found error naming its resource. It overrides Mapping.Code.
+type Coder interface {
+	Code() string
+}
+
+// entry pairs an error matcher with its mapping
+type entry struct {
+	match   func(err error) (error, bool) // Returns the matching error in the chain
+	mapping Mapping
+}
+
+// Registry maps errors to their API representation.
+// Packages register their sentinel errors and error types, typically from an
+// init function, so the HTTP layer needs no knowledge of every aggregate.
+type Registry struct {
+	entries []entry
+	mu      sync.RWMutex
+}
+
+// NewRegistry creates an empty error registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register maps a sentinel error, matched with errors.Is.
+func (r *Registry) Register(target error, mapping Mapping) {
+	r.add(entry{
+		match: func(err error) (error, bool) {
+			return target, errors.Is(err, target)
+		},
+		mapping: mapping,
+	})
+}
+
+// RegisterType maps every error of type T, matched with errors.As.
+// It is a function rather than a method because methods can't have type parameters.
+func RegisterType[T error](r *Registry, mapping Mapping) {
+	r.add(entry{
+		match: func(err error) (error, bool) {
+			var target T
+			if errors.As(err, &target) {
+				return target, true
+			}
+			return nil, false
+		},
+		mapping: mapping,
+	})
+}
+
+// add appends an entry to the registry
+func (r *Registry) add(e entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, e)
+}
+
+// Lookup returns the mapping of the first registered entry matching err.
+// Packages are initialized before the packages importing them, so the
+// cross-cutting errors registered here, such as an expired deadline, take
+// precedence over the domain errors that may wrap them.
+func (r *Registry) Lookup(err error) (Mapping, bool) {
+	if err == nil {
+		return Mapping{}, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, e := range r.entries {
+		matched, ok := e.match(err)
+		if !ok {
+			continue
+		}
+		mapping := e.mapping
+		if coder, ok := matched.(Coder); ok {
+			mapping.Code = coder.Code()
+		}
+		return mapping, true
+	}
+	return Mapping{}, false
+}
+
+// defaultRegistry is the registry used by the package-level functions
+var defaultRegistry = NewRegistry()
+
+// Register maps a sentinel error in the default registry.
+func Register(target error, mapping Mapping) {
+	defaultRegistry.Register(target, mapping)
+}
+
+// RegisterErrorType maps every error of type T in the default registry.
+func RegisterErrorType[T error](mapping Mapping) {
+	RegisterType[T](defaultRegistry, mapping)
+}
+
+// Lookup returns the mapping of err in the default registry.
+func Lookup(err error) (Mapping, bool) {
+	return defaultRegistry.Lookup(err)
+}
+
+func init() {
+	Register(context.DeadlineExceeded, Mapping{
+		Status: http.StatusGatewayTimeout,
+		Code:   CodeRequestTimeout,
+		Title:  constants.RequestTimeout,
+		Detail: constants.RequestTimeoutMessageUI,
+	})
+	RegisterErrorType[*ErrInvalidRequest](Mapping{
+		Status: http.StatusBadRequest,
+		Code:   CodeInvalidRequest,
+		Title:  constants.InvalidRequestFormat,
+	})
+	RegisterErrorType[*ErrValidation](Mapping{
+		Status: http.StatusBadRequest,
+		Code:   CodeValidationFailed,
+	})
+	RegisterErrorType[*ErrNotFound](Mapping{
+		Status: http.StatusNotFound,
+		Code:   CodeNotFound,
+		Title:  constants.ResourceNotFound,
+	})
+	RegisterErrorType[*ErrTooManyAttempts](Mapping{
+		Status: http.StatusTooManyRequests,
+		Code:   CodeTooManyAttempts,
+	})
+}
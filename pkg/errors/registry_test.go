@@ -0,0 +1,71 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRegistryLookup(t *testing.T) {
+	errOrderNotFound := errors.New("order not found")
+
+	registry := NewRegistry()
+	registry.Register(context.DeadlineExceeded, Mapping{Status: http.StatusGatewayTimeout, Code: CodeRequestTimeout})
+	RegisterType[*ErrNotFound](registry, Mapping{Status: http.StatusNotFound, Code: CodeNotFound})
+	registry.Register(errOrderNotFound, Mapping{Status: http.StatusNotFound, Code: "order_not_found"})
+
+	tests := []struct {
+		name       string
+		err        error
+		wantFound  bool
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "Sentinel Matched Through Wrapping",
+			err:        fmt.Errorf("loading order: %w", errOrderNotFound),
+			wantFound:  true,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "order_not_found",
+		},
+		{
+			name:       "Type Code Overrides Mapping",
+			err:        &ErrNotFound{Resource: "Invoice", ID: 7},
+			wantFound:  true,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "invoice_not_found",
+		},
+		{
+			name:       "Type Code With Spaces",
+			err:        &ErrNotFound{Resource: "Invoice line", ID: 7},
+			wantFound:  true,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "invoice_line_not_found",
+		},
+		{
+			name:       "Earlier Registration Takes Precedence",
+			err:        fmt.Errorf("%w: %w", errOrderNotFound, context.DeadlineExceeded),
+			wantFound:  true,
+			wantStatus: http.StatusGatewayTimeout,
+			wantCode:   CodeRequestTimeout,
+		},
+		{
+			name: "Unregistered Error",
+			err:  errors.New("boom"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping, found := registry.Lookup(tt.err)
+			if found != tt.wantFound {
+				t.Fatalf("Lookup() found = %v, want %v", found, tt.wantFound)
+			}
+			if mapping.Status != tt.wantStatus || mapping.Code != tt.wantCode {
+				t.Errorf("Lookup() = %d %s, want %d %s", mapping.Status, mapping.Code, tt.wantStatus, tt.wantCode)
+			}
+		})
+	}
+}